@@ -7,6 +7,9 @@ import (
 	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/ticker"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/trade"
 	currencyclient "github.com/CoreumFoundation/CoreDEX-API/domain/currency/client"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	updateclient "github.com/CoreumFoundation/CoreDEX-API/domain/update/client"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
 )
 
 type Application struct {
@@ -15,20 +18,28 @@ type Application struct {
 	OHLC     *ohlc.Application
 	Order    *order.Application
 	Currency *currency.Application
+
+	updateClient updateproto.UpdateServiceClient
+	hub          *hub
 }
 
 func NewApplication() *Application {
 	currencyClient := currencyclient.Client()
 	currencyApp := currency.NewApplication(currencyClient)
 	ohlcApp := ohlc.NewApplication(currencyApp)
+	tickerApp := ticker.NewApplication(ohlcApp)
 
-	return &Application{
+	app := &Application{
 		Trade:    trade.NewApplication(currencyApp),
-		Ticker:   ticker.NewApplication(ohlcApp),
+		Ticker:   tickerApp,
 		OHLC:     ohlcApp,
-		Order:    order.NewApplication(currencyApp),
+		Order:    order.NewApplication(currencyApp, tickerApp),
 		Currency: currency.NewApplication(currencyClient),
+
+		updateClient: updateclient.Client(),
 	}
+	app.hub = newHub(broker.New(BROKER_ENDPOINT), app.writeMessage, app.disconnect, app.fetch, TICKER_REFRESH)
+	return app
 }
 
 func (app *Application) Health() error {
@@ -0,0 +1,214 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+// channelItem is an element of the content of an item based channel: A trade, an order event or an OHLC period
+type channelItem struct {
+	key     string
+	time    int64 // Block time of a trade or order event, start of an OHLC period (unix seconds)
+	content json.RawMessage
+}
+
+// channel is the state of a unique subscription (network, method and ID), shared by all its listeners in all replicas.
+// Trades, order events and OHLC are item based: Only new and changed items are sent. The other methods send their
+// complete content when it changed.
+type channel struct {
+	subscription *updateproto.Subscription // Network, Method and ID of the channel
+	sequence     int64                     // Sequence of the last message sent
+	initialized  bool                      // The content has been retrieved at least once
+	content      string
+	items        map[string]channelItem
+}
+
+func newChannel(subscription *updateproto.Subscription) *channel {
+	return &channel{
+		subscription: &updateproto.Subscription{
+			Method:  subscription.Method,
+			ID:      subscription.ID,
+			Network: subscription.Network,
+		},
+		items: make(map[string]channelItem),
+	}
+}
+
+func channelKey(subscription *updateproto.Subscription) string {
+	return fmt.Sprintf("%s-%s-%s", subscription.Network, subscription.Method, subscription.ID)
+}
+
+func itemBased(method updateproto.Method) bool {
+	switch method {
+	case updateproto.Method_TRADES_FOR_SYMBOL,
+		updateproto.Method_TRADES_FOR_ACCOUNT,
+		updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL,
+		updateproto.Method_ORDERS_FOR_ACCOUNT,
+		updateproto.Method_OHLC:
+		return true
+	}
+	return false
+}
+
+// channelRefresh is the content of a channel as retrieved from the store or the chain
+type channelRefresh struct {
+	content string
+	items   []channelItem
+	since   int64 // Items before are outside the window of the method and removed from the channel
+}
+
+// apply updates the channel with the refreshed content and returns the content of the delta message, false if
+// nothing changed
+func (c *channel) apply(r *channelRefresh) (string, bool) {
+	c.initialized = true
+	if !itemBased(c.subscription.Method) {
+		if r.content == c.content {
+			return "", false
+		}
+		c.content = r.content
+		return r.content, true
+	}
+	changed := make([]channelItem, 0)
+	for _, item := range r.items {
+		if existing, ok := c.items[item.key]; ok && string(existing.content) == string(item.content) {
+			continue
+		}
+		c.items[item.key] = item
+		changed = append(changed, item)
+	}
+	for key, item := range c.items {
+		if item.time < r.since {
+			delete(c.items, key)
+		}
+	}
+	if len(changed) == 0 {
+		return "", false
+	}
+	return c.itemsContent(changed), true
+}
+
+// snapshot returns the complete content of the channel
+func (c *channel) snapshot() string {
+	if !itemBased(c.subscription.Method) {
+		return c.content
+	}
+	items := make([]channelItem, 0, len(c.items))
+	for _, item := range c.items {
+		items = append(items, item)
+	}
+	return c.itemsContent(items)
+}
+
+// itemsContent returns the items as JSON array in the order of the restful API: Trades and order events newest first,
+// OHLC oldest first
+func (c *channel) itemsContent(items []channelItem) string {
+	newestFirst := c.subscription.Method != updateproto.Method_OHLC
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].time != items[j].time {
+			return (items[i].time > items[j].time) == newestFirst
+		}
+		return items[i].key < items[j].key
+	})
+	contents := make([]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		contents = append(contents, item.content)
+	}
+	b, err := json.Marshal(contents)
+	if err != nil {
+		return "[]"
+	}
+	return string(b)
+}
+
+// message returns the message for the listeners of the channel
+func (c *channel) message(content string, updateType updateproto.UpdateType) *updateproto.Subscription {
+	return &updateproto.Subscription{
+		Method:   c.subscription.Method,
+		ID:       c.subscription.ID,
+		Network:  c.subscription.Network,
+		Content:  content,
+		Sequence: c.sequence,
+		Type:     updateType,
+	}
+}
+
+// affected returns if the content of the subscription can have been changed by the changes
+func affected(subscription *updateproto.Subscription, changes *updateproto.Changes) bool {
+	if subscription.Network != changes.Network {
+		return false
+	}
+	switch subscription.Method {
+	case updateproto.Method_TRADES_FOR_SYMBOL:
+		return containsSymbol(changes.TradeSymbols, subscription.ID)
+	case updateproto.Method_TRADES_FOR_ACCOUNT:
+		return contains(changes.Accounts, subscription.ID)
+	case updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL:
+		account, sym := splitAccount(subscription.ID)
+		return contains(changes.Accounts, account) && containsSymbol(changes.TradeSymbols, sym)
+	case updateproto.Method_OHLC:
+		return containsSymbol(changes.OHLCSymbols, symbolOf(subscription.ID))
+	case updateproto.Method_ORDERBOOK:
+		return containsSymbol(changes.OrderBookSymbols, subscription.ID)
+	case updateproto.Method_DEPTH:
+		return containsSymbol(changes.OrderBookSymbols, symbolOf(subscription.ID))
+	case updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT:
+		account, sym := splitAccount(subscription.ID)
+		return contains(changes.Accounts, account) && containsSymbol(changes.OrderBookSymbols, sym)
+	case updateproto.Method_ORDERS_FOR_ACCOUNT:
+		account, sym := splitAccount(subscription.ID)
+		return contains(changes.Accounts, account) && (sym == "" || containsSymbol(changes.OrderBookSymbols, sym))
+	case updateproto.Method_ORDERBOOKS_FOR_ACCOUNT, updateproto.Method_WALLET:
+		return contains(changes.Accounts, subscription.ID)
+	case updateproto.Method_TX_STATUS:
+		// The status of a pending transaction can change with every block
+		return changes.BlockHeight > 0
+	}
+	// TICKER is refreshed periodically (cached)
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// containsSymbol compares the symbols ({denom1}_{denom2}) independent of the order of the denoms
+func containsSymbol(symbols []string, sym string) bool {
+	denoms := strings.Split(sym, "_")
+	if len(denoms) != 2 {
+		return false
+	}
+	inverse := denoms[1] + "_" + denoms[0]
+	for _, s := range symbols {
+		if s == sym || s == inverse {
+			return true
+		}
+	}
+	return false
+}
+
+// splitAccount splits an ID in the format {account}_{denom1}_{denom2}
+func splitAccount(id string) (string, string) {
+	parts := strings.SplitN(id, "_", 2)
+	if len(parts) != 2 {
+		return id, ""
+	}
+	return parts[0], parts[1]
+}
+
+// symbolOf returns the symbol of an ID in the format {denom1}_{denom2}_{parameter}
+func symbolOf(id string) string {
+	parts := strings.Split(id, "_")
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[0] + "_" + parts[1]
+}
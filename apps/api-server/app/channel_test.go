@@ -0,0 +1,116 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+func Test_ApplyItems(t *testing.T) {
+	c := newChannel(&updateproto.Subscription{Method: updateproto.Method_TRADES_FOR_SYMBOL, ID: "a_b"})
+	content, changed := c.apply(&channelRefresh{
+		items: []channelItem{
+			{key: "tx1-1", time: 100, content: []byte(`{"T":1}`)},
+			{key: "tx2-2", time: 110, content: []byte(`{"T":2}`)},
+		},
+		since: 50,
+	})
+	if !changed || content != `[{"T":2},{"T":1}]` {
+		t.Errorf("Expected both trades newest first, got %s (%v)", content, changed)
+	}
+	// Known trades are not sent again
+	content, changed = c.apply(&channelRefresh{
+		items: []channelItem{
+			{key: "tx2-2", time: 110, content: []byte(`{"T":2}`)},
+			{key: "tx3-3", time: 120, content: []byte(`{"T":3}`)},
+		},
+		since: 105,
+	})
+	if !changed || content != `[{"T":3}]` {
+		t.Errorf("Expected only the new trade, got %s (%v)", content, changed)
+	}
+	// The trade before the window is removed
+	if snapshot := c.snapshot(); snapshot != `[{"T":3},{"T":2}]` {
+		t.Errorf("Unexpected snapshot %s", snapshot)
+	}
+	if _, changed = c.apply(&channelRefresh{items: []channelItem{{key: "tx3-3", time: 120, content: []byte(`{"T":3}`)}}, since: 105}); changed {
+		t.Errorf("Expected no change")
+	}
+}
+
+func Test_ApplyOHLC(t *testing.T) {
+	c := newChannel(&updateproto.Subscription{Method: updateproto.Method_OHLC, ID: "a_b_1m"})
+	c.apply(&channelRefresh{
+		items: []channelItem{
+			{key: "60", time: 60, content: []byte(`[60,"1"]`)},
+			{key: "120", time: 120, content: []byte(`[120,"2"]`)},
+		},
+	})
+	// The current period changed
+	content, changed := c.apply(&channelRefresh{
+		items: []channelItem{
+			{key: "60", time: 60, content: []byte(`[60,"1"]`)},
+			{key: "120", time: 120, content: []byte(`[120,"3"]`)},
+		},
+	})
+	if !changed || content != `[[120,"3"]]` {
+		t.Errorf("Expected the changed period, got %s (%v)", content, changed)
+	}
+	if snapshot := c.snapshot(); snapshot != `[[60,"1"],[120,"3"]]` {
+		t.Errorf("Expected the periods oldest first, got %s", snapshot)
+	}
+}
+
+func Test_ApplyContent(t *testing.T) {
+	c := newChannel(&updateproto.Subscription{Method: updateproto.Method_ORDERBOOK, ID: "a_b"})
+	if _, changed := c.apply(&channelRefresh{content: `{"Buy":[]}`}); !changed {
+		t.Errorf("Expected the first content to be a change")
+	}
+	if _, changed := c.apply(&channelRefresh{content: `{"Buy":[]}`}); changed {
+		t.Errorf("Expected no change for the same content")
+	}
+	if content, changed := c.apply(&channelRefresh{content: `{"Buy":[1]}`}); !changed || content != `{"Buy":[1]}` {
+		t.Errorf("Expected the complete content, got %s (%v)", content, changed)
+	}
+}
+
+func Test_Affected(t *testing.T) {
+	changes := &updateproto.Changes{
+		Network:          metadata.Network_MAINNET,
+		BlockHeight:      10,
+		TradeSymbols:     []string{"b_a"},
+		OrderBookSymbols: []string{"a_b"},
+		Accounts:         []string{"acc1"},
+	}
+	tests := []struct {
+		method   updateproto.Method
+		id       string
+		network  metadata.Network
+		expected bool
+	}{
+		{updateproto.Method_TRADES_FOR_SYMBOL, "a_b", metadata.Network_MAINNET, true},
+		{updateproto.Method_TRADES_FOR_SYMBOL, "a_b", metadata.Network_TESTNET, false},
+		{updateproto.Method_TRADES_FOR_SYMBOL, "a_c", metadata.Network_MAINNET, false},
+		{updateproto.Method_TRADES_FOR_ACCOUNT, "acc1", metadata.Network_MAINNET, true},
+		{updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL, "acc1_a_b", metadata.Network_MAINNET, true},
+		{updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL, "acc2_a_b", metadata.Network_MAINNET, false},
+		{updateproto.Method_ORDERBOOK, "b_a", metadata.Network_MAINNET, true},
+		{updateproto.Method_DEPTH, "a_b_0.1", metadata.Network_MAINNET, true},
+		{updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT, "acc1_a_b", metadata.Network_MAINNET, true},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "acc1", metadata.Network_MAINNET, true},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "acc1_b_a", metadata.Network_MAINNET, true},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "acc1_a_c", metadata.Network_MAINNET, false},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "acc2", metadata.Network_MAINNET, false},
+		{updateproto.Method_WALLET, "acc1", metadata.Network_MAINNET, true},
+		{updateproto.Method_OHLC, "a_b_1m", metadata.Network_MAINNET, false},
+		{updateproto.Method_TX_STATUS, "hash", metadata.Network_MAINNET, true},
+		{updateproto.Method_TICKER, "a_b", metadata.Network_MAINNET, false},
+	}
+	for _, tt := range tests {
+		subscription := &updateproto.Subscription{Method: tt.method, ID: tt.id, Network: tt.network}
+		if got := affected(subscription, changes); got != tt.expected {
+			t.Errorf("%s %s %s: expected %v, got %v", tt.network, tt.method, tt.id, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,428 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const (
+	// BROKER_ENDPOINT is the environment variable with the URL of the broker between the replicas
+	BROKER_ENDPOINT = "WS_BROKER"
+	UPDATES_TOPIC   = "coredex.ws.updates"  // Producer to replicas
+	REQUESTS_TOPIC  = "coredex.ws.requests" // Replicas to producer
+	ELECTION        = "coredex.ws.producer"
+)
+
+// update is a message of the producer for the listeners of a channel
+type update struct {
+	Replica      string `json:",omitempty"` // Only for the listeners of this replica (requested snapshot), all replicas if empty
+	Subscription *updateproto.Subscription
+}
+
+// request announces the subscriptions of a replica to the producer
+type request struct {
+	Replica       string
+	Subscriptions []*updateproto.Subscription
+	// New listeners for the subscriptions which need a snapshot. Without, the request holds all subscriptions of
+	// the replica (periodic announcement).
+	Snapshot bool
+}
+
+// listener is a connection of a client which receives the messages of its subscriptions: A websocket or an event
+// stream (Server-Sent Events)
+type listener interface {
+	Close() error
+}
+
+type queueItem struct {
+	ws listener
+	m  *message
+}
+
+type subscriptionManager struct {
+	bufferChan chan queueItem
+	slow       bool // The websocket is disconnected for not keeping up with its messages
+}
+
+// localChannel is a channel with listeners in this replica
+type localChannel struct {
+	subscription *updateproto.Subscription
+	sockets      map[listener]bool // true once the listener received the snapshot
+}
+
+func (c *localChannel) has(ws listener) bool {
+	_, ok := c.sockets[ws]
+	return ok
+}
+
+// requestOf returns the network, method and ID of the subscription, which identify the request in the replies
+func requestOf(subscription *updateproto.Subscription) *updateproto.Subscription {
+	return &updateproto.Subscription{
+		Method:  subscription.Method,
+		ID:      subscription.ID,
+		Network: subscription.Network,
+	}
+}
+
+// hub holds the listeners (websockets and event streams) of this replica and fans out the updates of the producer to them.
+// The replicas campaign to run the producer: One replica computes the updates for all replicas.
+type hub struct {
+	replica string
+	broker  broker.Broker
+	// write sends the message to the listener
+	write func(ws listener, m *message)
+	// disconnect closes the listener which does not keep up with its messages
+	disconnect func(ws listener)
+	fetch      fetchFunc
+
+	electionInterval time.Duration
+	announceInterval time.Duration
+	tickerRefresh    int
+
+	subscriptionMutex sync.RWMutex
+	// Subscriptions per listener
+	listeners map[listener][]*updateproto.Subscription
+	// Channels with listeners by key
+	subscribers map[string]*localChannel
+	// Subscriptions which need a snapshot by channel key, collected by run when signalled. Subscribing never waits for
+	// run, which does not collect while it resubscribes to the broker.
+	snapshotRequests map[string]*updateproto.Subscription
+	snapshotSignal   chan struct{}
+
+	senderMutex sync.Mutex
+	senders     map[listener]*subscriptionManager
+}
+
+func newHub(b broker.Broker, write func(ws listener, m *message), disconnect func(ws listener), fetch fetchFunc, tickerRefresh int) *hub {
+	return &hub{
+		replica:          uuid.NewString(),
+		broker:           b,
+		write:            write,
+		disconnect:       disconnect,
+		fetch:            fetch,
+		electionInterval: ELECTION_INTERVAL,
+		announceInterval: ANNOUNCE_INTERVAL,
+		tickerRefresh:    tickerRefresh,
+		listeners:        make(map[listener][]*updateproto.Subscription),
+		subscribers:      make(map[string]*localChannel),
+		snapshotRequests: make(map[string]*updateproto.Subscription),
+		snapshotSignal:   make(chan struct{}, 1),
+		senders:          make(map[listener]*subscriptionManager),
+	}
+}
+
+// run fans out the updates to the websockets of this replica and runs the producer while this replica is elected
+func (h *hub) run(ctx context.Context, source changesSource) {
+	updates := h.subscribeUpdates(ctx)
+	if updates == nil {
+		return
+	}
+	electionTicker := time.NewTicker(h.electionInterval)
+	defer electionTicker.Stop()
+	announceTicker := time.NewTicker(h.announceInterval)
+	defer announceTicker.Stop()
+	var stopProducer context.CancelFunc
+	defer func() {
+		if stopProducer != nil {
+			stopProducer()
+		}
+	}()
+	campaign := func() {
+		// The leadership expires when the leader stops extending it (e.g. the replica died)
+		leader, err := h.broker.Campaign(ctx, ELECTION, h.replica, 3*h.electionInterval)
+		if err != nil {
+			logger.Warnf("Campaigning for the websocket producer failed: %v", err)
+		}
+		switch {
+		case leader && stopProducer == nil:
+			logger.Infof("Replica %s produces the websocket updates", h.replica)
+			var producerCtx context.Context
+			producerCtx, stopProducer = context.WithCancel(ctx)
+			go newProducer(h.broker, h.fetch, 3*h.announceInterval, h.tickerRefresh).run(producerCtx, source)
+			// The new producer learns the subscriptions of all replicas from their announcements
+		case !leader && stopProducer != nil:
+			logger.Infof("Replica %s stopped producing the websocket updates", h.replica)
+			stopProducer()
+			stopProducer = nil
+		}
+	}
+	campaign()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-electionTicker.C:
+			campaign()
+		case <-announceTicker.C:
+			h.announce(ctx)
+		case <-h.snapshotSignal:
+			if subscriptions := h.pendingSnapshots(); len(subscriptions) > 0 {
+				h.publishRequest(ctx, &request{Replica: h.replica, Subscriptions: subscriptions, Snapshot: true})
+			}
+		case data, ok := <-updates:
+			if !ok {
+				// The subscription ended (e.g. lost connection to the broker): Updates can have been missed
+				if updates = h.subscribeUpdates(ctx); updates == nil {
+					return
+				}
+				h.resync(ctx)
+				continue
+			}
+			u := &update{}
+			if err := json.Unmarshal(data, u); err != nil || u.Subscription == nil {
+				logger.Warnf("Invalid websocket update: %v", err)
+				continue
+			}
+			h.deliver(ctx, u)
+		}
+	}
+}
+
+// subscribeUpdates subscribes to the updates of the producer, retrying until the context is done (nil)
+func (h *hub) subscribeUpdates(ctx context.Context) <-chan []byte {
+	for {
+		updates, err := h.broker.Subscribe(ctx, UPDATES_TOPIC)
+		if err == nil {
+			return updates
+		}
+		logger.Warnf("Subscribing to the websocket updates failed: %v", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(CHANGES_RETRY_INTERVAL):
+		}
+	}
+}
+
+// deliver sends the update to the listeners of the channel: Snapshots to the listeners waiting for one (all for a
+// snapshot of all replicas), deltas only to listeners which received the snapshot
+func (h *hub) deliver(ctx context.Context, u *update) {
+	if u.Replica != "" && u.Replica != h.replica {
+		return
+	}
+	// One message for all listeners, which share its encoding
+	m := newMessage(&updateproto.Subscribe{Action: updateproto.Action_RESPONSE, Subscription: u.Subscription})
+	h.subscriptionMutex.Lock()
+	if c, ok := h.subscribers[channelKey(u.Subscription)]; ok {
+		for ws, received := range c.sockets {
+			if u.Subscription.Type == updateproto.UpdateType_DELTA && !received {
+				continue
+			}
+			if u.Subscription.Type == updateproto.UpdateType_SNAPSHOT && u.Replica != "" && received {
+				continue
+			}
+			c.sockets[ws] = true
+			h.addToQueue(ctx, ws, m)
+		}
+	}
+	h.subscriptionMutex.Unlock()
+}
+
+// announce publishes all subscriptions of this replica, which keeps the channels alive in the producer
+func (h *hub) announce(ctx context.Context) {
+	h.publishRequest(ctx, &request{Replica: h.replica, Subscriptions: h.subscriptions(false)})
+}
+
+// pendingSnapshots returns and clears the subscriptions which need a snapshot
+func (h *hub) pendingSnapshots() []*updateproto.Subscription {
+	h.subscriptionMutex.Lock()
+	subscriptions := make([]*updateproto.Subscription, 0, len(h.snapshotRequests))
+	for key, subscription := range h.snapshotRequests {
+		subscriptions = append(subscriptions, subscription)
+		delete(h.snapshotRequests, key)
+	}
+	h.subscriptionMutex.Unlock()
+	return subscriptions
+}
+
+// resync requests new snapshots for all listeners
+func (h *hub) resync(ctx context.Context) {
+	h.publishRequest(ctx, &request{Replica: h.replica, Subscriptions: h.subscriptions(true), Snapshot: true})
+}
+
+// subscriptions returns the subscriptions with listeners in this replica. With reset, the listeners wait for a new
+// snapshot.
+func (h *hub) subscriptions(reset bool) []*updateproto.Subscription {
+	h.subscriptionMutex.Lock()
+	subscriptions := make([]*updateproto.Subscription, 0, len(h.subscribers))
+	for _, c := range h.subscribers {
+		if reset {
+			for ws := range c.sockets {
+				c.sockets[ws] = false
+			}
+		}
+		subscriptions = append(subscriptions, c.subscription)
+	}
+	h.subscriptionMutex.Unlock()
+	return subscriptions
+}
+
+func (h *hub) publishRequest(ctx context.Context, r *request) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		logger.Errorf("Error marshalling the websocket request: %v", err)
+		return
+	}
+	if err := h.broker.Publish(ctx, REQUESTS_TOPIC, b); err != nil {
+		logger.Warnf("Publishing the websocket request failed: %v", err)
+	}
+}
+
+/* Self terminating pool of go routines to write to the websockets
+The self termination design here has been choosen to avoid having to cleanup go routines when the client disconnects
+The process is as follows:
+1. Check if there is a go routine for the socket in the senders map, if not:
+2. Create a channel for the socket in the senders map
+3. Start a go routine for the socket
+About the go routine:
+4. Go routine has a time out on which it terminates itself
+5. go routine removes itself from the list of senders
+
+Since we use the 3*REFRESH_INTERVAL as the time out for termination, the number of initializations and terminations is limited
+*/
+
+func (h *hub) addToQueue(ctx context.Context, ws listener, m *message) {
+	h.senderMutex.Lock()
+	sender, ok := h.senders[ws]
+	if !ok {
+		sender = &subscriptionManager{
+			bufferChan: make(chan queueItem, WRITE_CHANNEL_SIZE),
+		}
+		h.senders[ws] = sender
+		go h.startSender(ctx, ws, sender.bufferChan)
+	}
+	switch {
+	case sender.slow:
+	case len(sender.bufferChan) < WRITE_CHANNEL_SIZE:
+		sender.bufferChan <- queueItem{ws, m}
+	default:
+		// Dropping the message would leave the client with a gap it does not know about: Disconnect instead, the
+		// client reconnects and receives new snapshots.
+		// Not waiting for the disconnect, since the caller can hold the subscriptionMutex.
+		sender.slow = true
+		go h.disconnect(ws)
+	}
+	h.senderMutex.Unlock()
+}
+
+// reply queues the response to a request of the websocket, ordered with the updates
+func (h *hub) reply(ws listener, m *updateproto.Subscribe) {
+	// The sender terminates itself when idle, so it does not need the lifetime of the hub
+	h.addToQueue(context.Background(), ws, newMessage(m))
+}
+
+func (h *hub) startSender(ctx context.Context, ws listener, senderChan chan queueItem) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case writeRequest := <-senderChan:
+			h.write(writeRequest.ws, writeRequest.m)
+		case <-time.After(3 * REFRESH_INTERVAL):
+			h.senderMutex.Lock()
+			// Messages queued while timing out are written by a new sender
+			if len(senderChan) > 0 {
+				h.senderMutex.Unlock()
+				continue
+			}
+			delete(h.senders, ws)
+			h.senderMutex.Unlock()
+			return
+		}
+	}
+}
+
+func (h *hub) addSocket(ws listener) {
+	h.subscriptionMutex.Lock()
+	h.listeners[ws] = make([]*updateproto.Subscription, 0)
+	h.subscriptionMutex.Unlock()
+}
+
+// subscribe adds the subscription of the websocket and requests the snapshot of the channel, unless the websocket
+// reached the limit of subscriptions (0 is unlimited). Subscribing again requests a new snapshot (e.g. after a missed
+// message).
+func (h *hub) subscribe(ws listener, subscription *updateproto.Subscription, limit int) error {
+	key := channelKey(subscription)
+	h.subscriptionMutex.Lock()
+	c, ok := h.subscribers[key]
+	subscribed := ok && c.has(ws)
+	if !subscribed && limit > 0 && len(h.listeners[ws]) >= limit {
+		h.subscriptionMutex.Unlock()
+		return ErrSubscriptionLimit
+	}
+	if !ok {
+		c = &localChannel{subscription: subscription, sockets: make(map[listener]bool)}
+		h.subscribers[key] = c
+	}
+	if !subscribed {
+		h.listeners[ws] = append(h.listeners[ws], subscription)
+	}
+	c.sockets[ws] = false
+	// Acknowledged before the snapshot is requested, so the acknowledgement precedes the snapshot
+	h.reply(ws, &updateproto.Subscribe{Action: updateproto.Action_ACK, Subscription: requestOf(subscription)})
+	h.snapshotRequests[key] = subscription
+	h.subscriptionMutex.Unlock()
+	// A pending signal already makes run collect this request
+	select {
+	case h.snapshotSignal <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (h *hub) unsubscribe(ws listener, subscription *updateproto.Subscription) error {
+	key := channelKey(subscription)
+	h.subscriptionMutex.Lock()
+	if c, ok := h.subscribers[key]; !ok || !c.has(ws) {
+		h.subscriptionMutex.Unlock()
+		return ErrNotSubscribed
+	}
+	h.removeListener(ws, key)
+	subscriptions := h.listeners[ws][:0]
+	for _, sub := range h.listeners[ws] {
+		if channelKey(sub) != key {
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+	h.listeners[ws] = subscriptions
+	h.reply(ws, &updateproto.Subscribe{Action: updateproto.Action_ACK, Subscription: requestOf(subscription)})
+	h.subscriptionMutex.Unlock()
+	return nil
+}
+
+// close removes all subscriptions of the websocket, returns false if the websocket was already closed
+func (h *hub) close(ws listener) bool {
+	h.subscriptionMutex.Lock()
+	subscriptions, ok := h.listeners[ws]
+	if !ok {
+		h.subscriptionMutex.Unlock()
+		return false
+	}
+	for _, subscription := range subscriptions {
+		h.removeListener(ws, channelKey(subscription))
+	}
+	delete(h.listeners, ws)
+	h.subscriptionMutex.Unlock()
+	return true
+}
+
+// removeListener removes the websocket from the channel, the channel is removed with its last listener.
+// The producer removes the channel when no replica announces it anymore.
+func (h *hub) removeListener(ws listener, key string) {
+	c, ok := h.subscribers[key]
+	if !ok {
+		return
+	}
+	delete(c.sockets, ws)
+	if len(c.sockets) == 0 {
+		delete(h.subscribers, key)
+	}
+}
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
+)
+
+// testSource provides the changes to the producer and counts the retrievals of the channels
+type testSource struct {
+	mutex   sync.Mutex
+	content string
+	fetches int
+	events  chan changesEvent
+}
+
+func (s *testSource) fetch(_ context.Context, _ *updateproto.Subscription, _ *updateproto.Changes, _ time.Time) (*channelRefresh, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fetches++
+	return &channelRefresh{content: s.content}, nil
+}
+
+func (s *testSource) changes(ctx context.Context, events chan<- changesEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-s.events:
+			events <- event
+		}
+	}
+}
+
+func (s *testSource) set(content string) {
+	s.mutex.Lock()
+	s.content = content
+	s.mutex.Unlock()
+}
+
+func newTestHub(b broker.Broker, s *testSource) (*hub, chan *updateproto.Subscribe) {
+	messages := make(chan *updateproto.Subscribe, 10)
+	h := newHub(b, func(_ listener, m *message) { messages <- m.Subscribe }, func(listener) {}, s.fetch, TICKER_REFRESH)
+	h.electionInterval = 20 * time.Millisecond
+	h.announceInterval = 50 * time.Millisecond
+	return h, messages
+}
+
+func expectMessage(t *testing.T, messages chan *updateproto.Subscribe, updateType updateproto.UpdateType, sequence int64, content string) {
+	t.Helper()
+	select {
+	case r := <-messages:
+		m := r.Subscription
+		if r.Action != updateproto.Action_RESPONSE || m.Type != updateType || m.Sequence != sequence || m.Content != content {
+			t.Errorf("Expected %s %d %s, got %s %s %d %s", updateType, sequence, content, r.Action, m.Type, m.Sequence, m.Content)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("Expected %s %d %s, nothing received", updateType, sequence, content)
+	}
+}
+
+func expectAck(t *testing.T, messages chan *updateproto.Subscribe) {
+	t.Helper()
+	select {
+	case r := <-messages:
+		if r.Action != updateproto.Action_ACK {
+			t.Errorf("Expected ACK, got %s", r.Action)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected ACK, nothing received")
+	}
+}
+
+func Test_HubFanOut(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := broker.NewMemory()
+	s := &testSource{content: `{"V":1}`, events: make(chan changesEvent)}
+	leader, leaderMessages := newTestHub(b, s)
+	replica, replicaMessages := newTestHub(b, s)
+	go leader.run(ctx, s.changes)
+	time.Sleep(50 * time.Millisecond)
+	go replica.run(ctx, s.changes)
+
+	subscription := &updateproto.Subscription{
+		Network: metadata.Network_DEVNET,
+		Method:  updateproto.Method_ORDERBOOKS_FOR_ACCOUNT,
+		ID:      "account",
+	}
+	leaderWS, replicaWS := &websocket.Conn{}, &websocket.Conn{}
+	leader.addSocket(leaderWS)
+	if err := leader.subscribe(leaderWS, subscription, 0); err != nil {
+		t.Fatal(err)
+	}
+	expectAck(t, leaderMessages)
+	expectMessage(t, leaderMessages, updateproto.UpdateType_SNAPSHOT, 0, `{"V":1}`)
+	replica.addSocket(replicaWS)
+	if err := replica.subscribe(replicaWS, subscription, 0); err != nil {
+		t.Fatal(err)
+	}
+	expectAck(t, replicaMessages)
+	expectMessage(t, replicaMessages, updateproto.UpdateType_SNAPSHOT, 0, `{"V":1}`)
+
+	// The delta is computed once and received by the listeners of both replicas
+	s.set(`{"V":2}`)
+	s.events <- changesEvent{changes: &updateproto.Changes{Network: metadata.Network_DEVNET, Accounts: []string{"account"}}}
+	expectMessage(t, leaderMessages, updateproto.UpdateType_DELTA, 1, `{"V":2}`)
+	expectMessage(t, replicaMessages, updateproto.UpdateType_DELTA, 1, `{"V":2}`)
+	s.mutex.Lock()
+	if s.fetches != 2 {
+		t.Errorf("Expected 2 retrievals (snapshot and delta), got %d", s.fetches)
+	}
+	s.mutex.Unlock()
+
+	// Unsubscribed listeners receive no further updates
+	if err := replica.unsubscribe(replicaWS, subscription); err != nil {
+		t.Fatal(err)
+	}
+	expectAck(t, replicaMessages)
+	s.set(`{"V":3}`)
+	s.events <- changesEvent{changes: &updateproto.Changes{Network: metadata.Network_DEVNET, Accounts: []string{"account"}}}
+	expectMessage(t, leaderMessages, updateproto.UpdateType_DELTA, 2, `{"V":3}`)
+	select {
+	case m := <-replicaMessages:
+		t.Errorf("Unexpected %s after unsubscribing", m.Action)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func Test_HubSubscriptionLimit(t *testing.T) {
+	s := &testSource{}
+	h, messages := newTestHub(broker.NewMemory(), s)
+	ws := &websocket.Conn{}
+	h.addSocket(ws)
+	first := &updateproto.Subscription{Network: metadata.Network_DEVNET, Method: updateproto.Method_WALLET, ID: "a"}
+	second := &updateproto.Subscription{Network: metadata.Network_DEVNET, Method: updateproto.Method_WALLET, ID: "b"}
+	if err := h.subscribe(ws, first, 1); err != nil {
+		t.Fatal(err)
+	}
+	expectAck(t, messages)
+	if err := h.subscribe(ws, second, 1); err != ErrSubscriptionLimit {
+		t.Errorf("Expected the subscription limit, got %v", err)
+	}
+	// Subscribing again to the same channel does not count
+	if err := h.subscribe(ws, first, 1); err != nil {
+		t.Errorf("Expected the resubscription to succeed, got %v", err)
+	}
+	expectAck(t, messages)
+	if err := h.unsubscribe(ws, second); err != ErrNotSubscribed {
+		t.Errorf("Expected not subscribed, got %v", err)
+	}
+}
+
+func Test_HubSubscribeWithoutRun(t *testing.T) {
+	// The hub does not run (e.g. resubscribing to the broker): Subscribing does not wait for the snapshot requests
+	h := newHub(broker.NewMemory(), func(listener, *message) {}, func(listener) {}, (&testSource{}).fetch, TICKER_REFRESH)
+	subscription := &updateproto.Subscription{Network: metadata.Network_DEVNET, Method: updateproto.Method_WALLET, ID: "a"}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 2000; i++ {
+			ws := &websocket.Conn{}
+			h.addSocket(ws)
+			if err := h.subscribe(ws, subscription, 0); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatalf("Subscribing blocked")
+	}
+	// The listeners of a channel share the snapshot request
+	if pending := h.pendingSnapshots(); len(pending) != 1 {
+		t.Errorf("Expected 1 pending snapshot request, got %d", len(pending))
+	}
+	if pending := h.pendingSnapshots(); len(pending) != 0 {
+		t.Errorf("Expected the snapshot requests to be cleared, got %d", len(pending))
+	}
+}
+
+func Test_HubSlowConsumer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	blocked := make(chan struct{})
+	defer close(blocked)
+	disconnects := make(chan listener, 10)
+	h := newHub(broker.NewMemory(), func(listener, *message) { <-blocked },
+		func(ws listener) { disconnects <- ws }, (&testSource{}).fetch, TICKER_REFRESH)
+	ws := &websocket.Conn{}
+	// The first message blocks the sender, the next ones fill the buffer
+	for i := 0; i < WRITE_CHANNEL_SIZE+5; i++ {
+		h.addToQueue(ctx, ws, newMessage(&updateproto.Subscribe{}))
+		time.Sleep(time.Millisecond)
+	}
+	select {
+	case disconnected := <-disconnects:
+		if disconnected != ws {
+			t.Errorf("Unexpected websocket disconnected")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("The slow consumer was not disconnected")
+	}
+	select {
+	case <-disconnects:
+		t.Errorf("The slow consumer was disconnected more than once")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,170 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+)
+
+const (
+	// DEPTH_MAX_ORDERS is the maximum number of orders per side of the order book which is grouped into the depth levels
+	DEPTH_MAX_ORDERS = 5000
+	// DEPTH_LEVELS is the default number of levels per side
+	DEPTH_LEVELS = 50
+	// DEPTH_MAX_LEVELS is the maximum number of levels per side
+	DEPTH_MAX_LEVELS = 100
+	// depthGroupings is the number of groupings offered (price tick * 10^0 .. 10^(depthGroupings-1))
+	depthGroupings = 5
+)
+
+// ErrInvalidGrouping is returned when the grouping is not a multiple of the price tick of the market
+var ErrInvalidGrouping = errors.New("grouping is not a multiple of the price tick")
+
+// DepthLevel is a price bucket of the order book. Amounts of the base denom, quote amounts of the quote denom, all with
+// the precision applied.
+type DepthLevel struct {
+	Price                 string // Bucket price: rounded down for buys, up for sells
+	BaseAmount            string
+	QuoteAmount           string
+	CumulativeBaseAmount  string // Total of this and all better levels
+	CumulativeQuoteAmount string
+	Orders                int
+}
+
+// Depth is the order book grouped into price buckets, best level first on both sides
+type Depth struct {
+	Symbol    string
+	Grouping  string   // Bucket size (human readable price)
+	Groupings []string // Groupings offered for the market, the first is the price tick
+	Buy       []DepthLevel
+	Sell      []DepthLevel
+	BestBuy   string `json:",omitempty"`
+	BestSell  string `json:",omitempty"`
+	Spread    string `json:",omitempty"` // Only present if both sides have orders
+	MidPrice  string `json:",omitempty"`
+}
+
+// Depth groups the order book of the symbol into buckets of the grouping (human readable price, multiple of the price
+// tick of the market). A zero grouping uses the price tick. At most levels buckets are returned per side, fewer if
+// the levels hold more than DEPTH_MAX_ORDERS orders.
+func (a *Application) Depth(ctx context.Context, network metadata.Network, sym *symbol.Symbol, grouping dec.Decimal, levels int) (*Depth, error) {
+	basePrecision, quotePrecision, err := a.currencyClient.Precisions(ctx, network, sym.Denom1, sym.Denom2)
+	if err != nil {
+		return nil, err
+	}
+	params, err := a.TxEncoder[network].reader.QueryOrderBookParams(ctx, sym.Denom1.Denom, sym.Denom2.Denom)
+	if err != nil {
+		return nil, err
+	}
+	// The price tick is in subunits
+	priceTick := dec.NewFromBigRat(params.PriceTick.Rat(), 18).
+		Mul(dec.New(1, basePrecision)).Div(dec.New(1, quotePrecision))
+	if grouping.IsZero() {
+		grouping = priceTick
+	}
+	if !grouping.IsPositive() || (priceTick.IsPositive() && !grouping.Mod(priceTick).IsZero()) {
+		return nil, ErrInvalidGrouping
+	}
+
+	// Every price tick of the requested levels can hold an order, one more order starts the bucket after the last
+	// level. If several orders share a price, the fetch is repeated with more orders until all levels are complete.
+	limit := levels + 1
+	if priceTick.IsPositive() {
+		ticks := grouping.Div(priceTick).Mul(dec.NewFromInt(int64(levels)))
+		limit = DEPTH_MAX_ORDERS
+		if ticks.LessThan(dec.NewFromInt(DEPTH_MAX_ORDERS)) {
+			limit = int(ticks.IntPart()) + 1
+		}
+	}
+	var asks, bids []quoteLevel
+	var buyLevels, sellLevels []DepthLevel
+	for {
+		limit = min(limit, DEPTH_MAX_ORDERS)
+		orderbook, err := a.OrderBookRelevantOrders(network, sym.Denom1.Denom, sym.Denom2.Denom, limit, false)
+		if err != nil {
+			return nil, err
+		}
+		asks = quoteLevels(orderbook.Sell, basePrecision, quotePrecision)
+		bids = quoteLevels(orderbook.Buy, basePrecision, quotePrecision)
+		// The sells are ordered descending, the best ask is the lowest price
+		for i, j := 0, len(asks)-1; i < j; i, j = i+1, j-1 {
+			asks[i], asks[j] = asks[j], asks[i]
+		}
+		// A side with limit orders might have been cut off by the limit
+		buyLevels = groupLevels(bids, grouping, false, levels, len(bids) >= limit)
+		sellLevels = groupLevels(asks, grouping, true, levels, len(asks) >= limit)
+		buyComplete := len(bids) < limit || len(buyLevels) == levels
+		sellComplete := len(asks) < limit || len(sellLevels) == levels
+		if (buyComplete && sellComplete) || limit == DEPTH_MAX_ORDERS {
+			break
+		}
+		limit *= 2
+	}
+	depth := &Depth{
+		Symbol:    sym.Denom1.Denom + "_" + sym.Denom2.Denom,
+		Grouping:  grouping.String(),
+		Groupings: make([]string, 0, depthGroupings),
+		Buy:       buyLevels,
+		Sell:      sellLevels,
+	}
+	for i := 0; i < depthGroupings; i++ {
+		depth.Groupings = append(depth.Groupings, priceTick.Mul(dec.New(1, int32(i))).String())
+	}
+	if len(bids) > 0 {
+		depth.BestBuy = bids[0].price.String()
+	}
+	if len(asks) > 0 {
+		depth.BestSell = asks[0].price.String()
+	}
+	if len(bids) > 0 && len(asks) > 0 {
+		depth.Spread = asks[0].price.Sub(bids[0].price).String()
+		depth.MidPrice = asks[0].price.Add(bids[0].price).Div(dec.NewFromInt(2)).String()
+	}
+	return depth, nil
+}
+
+// groupLevels groups the orders (best price first) into buckets of the grouping with cumulative totals.
+// Buy prices are rounded down and sell prices up to the bucket, so that the buckets of both sides do not overlap.
+// If the orders are truncated, the last bucket might miss orders and is left out unless a following bucket started.
+func groupLevels(orders []quoteLevel, grouping dec.Decimal, roundUp bool, maxLevels int, truncated bool) []DepthLevel {
+	res := make([]DepthLevel, 0)
+	var bucket, base, quote, cumulativeBase, cumulativeQuote dec.Decimal
+	orderCount := 0
+	flush := func() {
+		cumulativeBase = cumulativeBase.Add(base)
+		cumulativeQuote = cumulativeQuote.Add(quote)
+		res = append(res, DepthLevel{
+			Price:                 bucket.String(),
+			BaseAmount:            base.String(),
+			QuoteAmount:           quote.String(),
+			CumulativeBaseAmount:  cumulativeBase.String(),
+			CumulativeQuoteAmount: cumulativeQuote.String(),
+			Orders:                orderCount,
+		})
+	}
+	for _, order := range orders {
+		b := order.price.Div(grouping).Floor().Mul(grouping)
+		if roundUp {
+			b = order.price.Div(grouping).Ceil().Mul(grouping)
+		}
+		if orderCount > 0 && !b.Equal(bucket) {
+			flush()
+			if len(res) == maxLevels {
+				return res
+			}
+			base, quote, orderCount = dec.Zero, dec.Zero, 0
+		}
+		bucket = b
+		base = base.Add(order.quantity)
+		quote = quote.Add(order.quantity.Mul(order.price))
+		orderCount++
+	}
+	if orderCount > 0 && !truncated {
+		flush()
+	}
+	return res
+}
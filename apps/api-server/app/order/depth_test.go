@@ -0,0 +1,60 @@
+package order
+
+import (
+	"testing"
+
+	dec "github.com/shopspring/decimal"
+)
+
+func Test_GroupLevels(t *testing.T) {
+	bids := []quoteLevel{
+		{price: dec.RequireFromString("0.99"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("0.95"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("0.89"), quantity: dec.RequireFromString("5")},
+	}
+	grouping := dec.RequireFromString("0.1")
+	levels := groupLevels(bids, grouping, false, 10, false)
+	if len(levels) != 2 {
+		t.Fatalf("expected 2 levels, got %d", len(levels))
+	}
+	expected := []DepthLevel{
+		{Price: "0.9", BaseAmount: "20", QuoteAmount: "19.4", CumulativeBaseAmount: "20", CumulativeQuoteAmount: "19.4", Orders: 2},
+		{Price: "0.8", BaseAmount: "5", QuoteAmount: "4.45", CumulativeBaseAmount: "25", CumulativeQuoteAmount: "23.85", Orders: 1},
+	}
+	for i := range expected {
+		if levels[i] != expected[i] {
+			t.Errorf("level %d: got %+v, expected %+v", i, levels[i], expected[i])
+		}
+	}
+	// Sells are rounded up, so that the bucket does not overlap with the buys
+	asks := []quoteLevel{
+		{price: dec.RequireFromString("1.01"), quantity: dec.RequireFromString("1")},
+		{price: dec.RequireFromString("1.1"), quantity: dec.RequireFromString("1")},
+		{price: dec.RequireFromString("1.25"), quantity: dec.RequireFromString("1")},
+	}
+	levels = groupLevels(asks, grouping, true, 1, false)
+	if len(levels) != 1 || levels[0].Price != "1.1" || levels[0].BaseAmount != "2" {
+		t.Errorf("got %+v, expected a single level 1.1 with 2", levels)
+	}
+}
+
+func Test_GroupLevelsTruncated(t *testing.T) {
+	// The order list is cut off within the 0.8 bucket, further orders of the bucket are missing
+	bids := []quoteLevel{
+		{price: dec.RequireFromString("0.99"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("0.95"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("0.89"), quantity: dec.RequireFromString("5")},
+		{price: dec.RequireFromString("0.85"), quantity: dec.RequireFromString("5")},
+	}
+	grouping := dec.RequireFromString("0.1")
+	levels := groupLevels(bids, grouping, false, 10, true)
+	if len(levels) != 1 || levels[0].Price != "0.9" || levels[0].CumulativeBaseAmount != "20" {
+		t.Errorf("got %+v, expected only the complete level 0.9", levels)
+	}
+	// The bucket is complete once the next bucket started
+	bids = append(bids, quoteLevel{price: dec.RequireFromString("0.79"), quantity: dec.RequireFromString("1")})
+	levels = groupLevels(bids, grouping, false, 2, true)
+	if len(levels) != 2 || levels[1].Price != "0.8" || levels[1].BaseAmount != "10" || levels[1].CumulativeBaseAmount != "30" {
+		t.Errorf("got %+v, expected the complete levels 0.9 and 0.8", levels)
+	}
+}
@@ -0,0 +1,73 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	ordergrpcclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// OrderEvents returns the lifecycle events (placed, partially filled, filled, canceled, expired) of the orders of the
+// account between from (inclusive) and to (exclusive), newest first. The events of all markets if sym is nil.
+func (a *Application) OrderEvents(ctx context.Context, network metadata.Network, account string, sym *symbol.Symbol, from, to time.Time) ([]*dmn.OrderEvent, error) {
+	filter := &ordergrpc.OrderEventFilter{
+		Network: network,
+		Account: account,
+		From:    timestamppb.New(from),
+		To:      timestamppb.New(to),
+	}
+	if sym != nil {
+		filter.Denom1 = &sym.Denom1.Denom
+		filter.Denom2 = &sym.Denom2.Denom
+	}
+	events, err := a.orderClient.GetOrderEvents(ordergrpcclient.AuthCtx(ctx), filter)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*dmn.OrderEvent, 0, len(events.Events))
+	for _, event := range events.Events {
+		e, err := a.normalizeOrderEvent(ctx, network, event)
+		if err != nil {
+			logger.Errorf("Error normalizing the %s event of order %d: %v", event.Type.String(), event.Sequence, err)
+			continue
+		}
+		res = append(res, e)
+	}
+	return res, nil
+}
+
+// normalizeOrderEvent applies the precisions of the denoms like for the orders
+func (a *Application) normalizeOrderEvent(ctx context.Context, network metadata.Network, event *ordergrpc.OrderEvent) (*dmn.OrderEvent, error) {
+	if event.Quantity == nil {
+		event.Quantity = &decimal.Decimal{}
+	}
+	if event.RemainingQuantity == nil {
+		event.RemainingQuantity = &decimal.Decimal{}
+	}
+	o, err := a.Normalize(ctx, &ordergrpc.Order{
+		BaseDenom:         event.BaseDenom,
+		QuoteDenom:        event.QuoteDenom,
+		Price:             event.Price,
+		Quantity:          event.Quantity,
+		RemainingQuantity: event.RemainingQuantity,
+		Side:              event.Side,
+		MetaData:          &metadata.MetaData{Network: network},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dmn.OrderEvent{
+		OrderEvent:            event,
+		HumanReadablePrice:    o.HumanReadablePrice,
+		SymbolAmount:          o.SymbolAmount,
+		RemainingSymbolAmount: o.RemainingSymbolAmount,
+	}, nil
+}
@@ -0,0 +1,259 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	dec "github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	ordergrpcclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+// SERIES_MAX_POINTS is the maximum number of intervals of an order book series
+const SERIES_MAX_POINTS = 1000
+
+// ErrSnapshotNotFound is returned when there is no order book snapshot of the symbol at or before the requested time
+var ErrSnapshotNotFound = errors.New("order book snapshot not found")
+
+// OrderBookHistory is the order book of a symbol at a point in time, from the snapshots stored by the data-aggregator.
+// Holds the best orders of the snapshots, ordered like the live order book.
+type OrderBookHistory struct {
+	Symbol      string
+	At          int64 // Requested time (unix seconds)
+	BlockHeight int64 // Block of the most recent snapshot
+	BlockTime   int64
+	Buy         []*coreum.OrderBookOrder
+	Sell        []*coreum.OrderBookOrder
+}
+
+// OrderBookSeriesPoint is the state of the order book at the end of an interval. Prices and amounts with the
+// precisions applied, the depth covers the orders held by the snapshots.
+type OrderBookSeriesPoint struct {
+	Time           int64  // Start of the interval (unix seconds)
+	BestBuy        string `json:",omitempty"`
+	BestSell       string `json:",omitempty"`
+	Spread         string `json:",omitempty"` // Only present if both sides have orders
+	MidPrice       string `json:",omitempty"`
+	BuyDepth       string // Amount of the base denom
+	BuyDepthQuote  string // Amount of the quote denom
+	SellDepth      string
+	SellDepthQuote string
+	BuyOrders      int
+	SellOrders     int
+}
+
+type OrderBookSeries struct {
+	Symbol   string
+	Interval int64 // Seconds
+	Points   []OrderBookSeriesPoint
+}
+
+// OrderBookHistory returns the order book of the symbol at the time, from the latest snapshots at or before it
+func (a *Application) OrderBookHistory(ctx context.Context, network metadata.Network, sym *symbol.Symbol, at time.Time) (*OrderBookHistory, error) {
+	denom1, denom2 := sym.Denom1.Denom, sym.Denom2.Denom
+	res := &OrderBookHistory{Symbol: denom1 + "_" + denom2, At: at.Unix()}
+	orders := make([]dextypes.Order, 0)
+	found := false
+	for _, denoms := range [][2]string{{denom1, denom2}, {denom2, denom1}} {
+		snapshot, err := a.snapshotAt(ctx, network, denoms[0], denoms[1], at)
+		if err != nil {
+			return nil, err
+		}
+		// Only one of the order books has to exist
+		if snapshot == nil {
+			continue
+		}
+		found = true
+		if snapshot.BlockHeight > res.BlockHeight {
+			res.BlockHeight = snapshot.BlockHeight
+			res.BlockTime = snapshot.BlockTime.AsTime().Unix()
+		}
+		o, err := storedOrders(snapshot.BaseDenom, snapshot.QuoteDenom, snapshot.Buy, snapshot.Sell)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o...)
+	}
+	if !found {
+		return nil, ErrSnapshotNotFound
+	}
+	orderbook, err := coreum.NewOrderBookOrders(orders, denom1, denom2)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.normalizeOrderBook(ctx, network, denom1, denom2, orderbook); err != nil {
+		return nil, err
+	}
+	sortOrderBook(orderbook)
+	res.Buy = orderbook.Buy
+	res.Sell = orderbook.Sell
+	return res, nil
+}
+
+// OrderBookSeries returns the best prices, spread and depth of the order book of the symbol per interval from the
+// snapshots. Intervals without a snapshot carry the state of the previous interval forward.
+func (a *Application) OrderBookSeries(ctx context.Context, network metadata.Network, sym *symbol.Symbol, from, to time.Time, interval time.Duration) (*OrderBookSeries, error) {
+	seconds := int64(interval / time.Second)
+	// The intervals are aligned like the intervals of the store
+	start := from.Unix() / seconds * seconds
+	end := to.Unix()
+	basePrecision, quotePrecision, err := a.currencyClient.Precisions(ctx, network, sym.Denom1, sym.Denom2)
+	if err != nil {
+		return nil, err
+	}
+	denom1, denom2 := sym.Denom1.Denom, sym.Denom2.Denom
+	// The metrics of the order book denom1/denom2 and of the opposite order book, per interval
+	books := [2]struct {
+		current   *ordergrpc.OrderBookMetrics
+		intervals map[int64]*ordergrpc.OrderBookMetrics
+	}{}
+	for i, denoms := range [][2]string{{denom1, denom2}, {denom2, denom1}} {
+		// The state before the first interval
+		snapshot, err := a.snapshotAt(ctx, network, denoms[0], denoms[1], time.Unix(start-1, 0))
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			books[i].current = snapshot.Metrics
+		}
+		series, err := a.orderClient.GetOrderBookSnapshotSeries(ordergrpcclient.AuthCtx(ctx), &ordergrpc.OrderBookSnapshotSeriesFilter{
+			Network:    network,
+			BaseDenom:  denoms[0],
+			QuoteDenom: denoms[1],
+			From:       timestamppb.New(time.Unix(start, 0)),
+			To:         timestamppb.New(time.Unix(end, 0)),
+			Interval:   seconds,
+		})
+		if err != nil {
+			return nil, err
+		}
+		books[i].intervals = make(map[int64]*ordergrpc.OrderBookMetrics)
+		for _, s := range series.Snapshots {
+			books[i].intervals[s.BlockTime.AsTime().Unix()/seconds*seconds] = s.Metrics
+		}
+	}
+	res := &OrderBookSeries{
+		Symbol:   denom1 + "_" + denom2,
+		Interval: seconds,
+		Points:   make([]OrderBookSeriesPoint, 0),
+	}
+	for t := start; t < end; t += seconds {
+		for i := range books {
+			if metrics, ok := books[i].intervals[t]; ok {
+				books[i].current = metrics
+			}
+		}
+		// No point before the first snapshot
+		if books[0].current == nil && books[1].current == nil {
+			continue
+		}
+		res.Points = append(res.Points, seriesPoint(t, combineMetrics(books[0].current, books[1].current), basePrecision, quotePrecision))
+	}
+	return res, nil
+}
+
+// snapshotAt returns the latest snapshot of the on chain order book at or before the time, nil if there is none
+func (a *Application) snapshotAt(ctx context.Context, network metadata.Network, baseDenom, quoteDenom string, at time.Time) (*ordergrpc.OrderBookSnapshot, error) {
+	snapshot, err := a.orderClient.GetOrderBookSnapshot(ordergrpcclient.AuthCtx(ctx), &ordergrpc.OrderBookSnapshotQuery{
+		Network:    network,
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		At:         timestamppb.New(at),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// bookMetrics are the metrics of the order book denom1/denom2 in subunits
+type bookMetrics struct {
+	bestBuy, bestSell                                  dec.Decimal // Zero if the side has no orders
+	buyDepth, buyDepthQuote, sellDepth, sellDepthQuote dec.Decimal
+	buyOrders, sellOrders                              int
+}
+
+// combineMetrics combines the metrics of the order book denom1/denom2 (direct) and of the opposite order book
+// denom2/denom1 (inverted), either can be nil. A buy of denom2 in the opposite order book sells denom1 at the inverted
+// price and the other way around.
+func combineMetrics(direct, inverted *ordergrpc.OrderBookMetrics) bookMetrics {
+	res := bookMetrics{}
+	if direct != nil {
+		res.bestBuy = decimalOrZero(direct.BestBuy)
+		res.bestSell = decimalOrZero(direct.BestSell)
+		res.buyDepth = decimalOrZero(direct.BuyDepth)
+		res.buyDepthQuote = decimalOrZero(direct.BuyDepthQuote)
+		res.sellDepth = decimalOrZero(direct.SellDepth)
+		res.sellDepthQuote = decimalOrZero(direct.SellDepthQuote)
+		res.buyOrders = int(direct.BuyOrders)
+		res.sellOrders = int(direct.SellOrders)
+	}
+	if inverted == nil {
+		return res
+	}
+	if bestSell := decimalOrZero(inverted.BestSell); bestSell.IsPositive() {
+		if price := dec.NewFromInt(1).Div(bestSell); price.GreaterThan(res.bestBuy) {
+			res.bestBuy = price
+		}
+	}
+	if bestBuy := decimalOrZero(inverted.BestBuy); bestBuy.IsPositive() {
+		if price := dec.NewFromInt(1).Div(bestBuy); res.bestSell.IsZero() || price.LessThan(res.bestSell) {
+			res.bestSell = price
+		}
+	}
+	res.buyDepth = res.buyDepth.Add(decimalOrZero(inverted.SellDepthQuote))
+	res.buyDepthQuote = res.buyDepthQuote.Add(decimalOrZero(inverted.SellDepth))
+	res.sellDepth = res.sellDepth.Add(decimalOrZero(inverted.BuyDepthQuote))
+	res.sellDepthQuote = res.sellDepthQuote.Add(decimalOrZero(inverted.BuyDepth))
+	res.buyOrders += int(inverted.SellOrders)
+	res.sellOrders += int(inverted.BuyOrders)
+	return res
+}
+
+// seriesPoint applies the precisions to the metrics
+func seriesPoint(t int64, m bookMetrics, basePrecision, quotePrecision int32) OrderBookSeriesPoint {
+	price := func(p dec.Decimal) dec.Decimal {
+		return p.Mul(dec.New(1, basePrecision)).Div(dec.New(1, quotePrecision))
+	}
+	point := OrderBookSeriesPoint{
+		Time:           t,
+		BuyDepth:       m.buyDepth.Div(dec.New(1, basePrecision)).String(),
+		BuyDepthQuote:  m.buyDepthQuote.Div(dec.New(1, quotePrecision)).String(),
+		SellDepth:      m.sellDepth.Div(dec.New(1, basePrecision)).String(),
+		SellDepthQuote: m.sellDepthQuote.Div(dec.New(1, quotePrecision)).String(),
+		BuyOrders:      m.buyOrders,
+		SellOrders:     m.sellOrders,
+	}
+	bestBuy, bestSell := price(m.bestBuy), price(m.bestSell)
+	if bestBuy.IsPositive() {
+		point.BestBuy = bestBuy.String()
+	}
+	if bestSell.IsPositive() {
+		point.BestSell = bestSell.String()
+	}
+	if bestBuy.IsPositive() && bestSell.IsPositive() {
+		point.Spread = bestSell.Sub(bestBuy).String()
+		point.MidPrice = bestSell.Add(bestBuy).Div(dec.NewFromInt(2)).String()
+	}
+	return point
+}
+
+func decimalOrZero(s string) dec.Decimal {
+	d, err := dec.NewFromString(s)
+	if err != nil {
+		return dec.Zero
+	}
+	return d
+}
@@ -0,0 +1,71 @@
+package order
+
+import (
+	"testing"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+)
+
+func Test_CombineMetrics(t *testing.T) {
+	direct := &ordergrpc.OrderBookMetrics{
+		BestBuy:        "2",
+		BestSell:       "3",
+		BuyDepth:       "100",
+		BuyDepthQuote:  "200",
+		SellDepth:      "50",
+		SellDepthQuote: "150",
+		BuyOrders:      2,
+		SellOrders:     1,
+	}
+	// The opposite order book: A sell at 0.4 buys the base denom at 2.5, a buy at 0.25 sells it at 4
+	inverted := &ordergrpc.OrderBookMetrics{
+		BestBuy:        "0.25",
+		BestSell:       "0.4",
+		BuyDepth:       "80",
+		BuyDepthQuote:  "20",
+		SellDepth:      "40",
+		SellDepthQuote: "16",
+		BuyOrders:      3,
+		SellOrders:     4,
+	}
+	point := seriesPoint(60, combineMetrics(direct, inverted), 6, 3)
+	expected := OrderBookSeriesPoint{
+		Time:           60,
+		BestBuy:        "2500",
+		BestSell:       "3000",
+		Spread:         "500",
+		MidPrice:       "2750",
+		BuyDepth:       "0.000116",
+		BuyDepthQuote:  "0.24",
+		SellDepth:      "0.00007",
+		SellDepthQuote: "0.23",
+		BuyOrders:      6,
+		SellOrders:     4,
+	}
+	if point != expected {
+		t.Errorf("got %+v, expected %+v", point, expected)
+	}
+}
+
+func Test_CombineMetricsOneSide(t *testing.T) {
+	inverted := &ordergrpc.OrderBookMetrics{
+		BestBuy:        "0.25",
+		BuyDepth:       "80",
+		BuyDepthQuote:  "20",
+		SellDepth:      "0",
+		SellDepthQuote: "0",
+		BuyOrders:      1,
+	}
+	point := seriesPoint(0, combineMetrics(nil, inverted), 0, 0)
+	expected := OrderBookSeriesPoint{
+		BestSell:       "4",
+		BuyDepth:       "0",
+		BuyDepthQuote:  "0",
+		SellDepth:      "20",
+		SellDepthQuote: "80",
+		SellOrders:     1,
+	}
+	if point != expected {
+		t.Errorf("got %+v, expected %+v", point, expected)
+	}
+}
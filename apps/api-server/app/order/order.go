@@ -11,12 +11,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/samber/lo"
 	dec "github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	currency "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/currency"
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/ticker"
 	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
 	"github.com/CoreumFoundation/CoreDEX-API/coreum"
 	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
@@ -25,8 +28,11 @@ import (
 	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
 	ordergrpcclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	stategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/state"
+	stateclient "github.com/CoreumFoundation/CoreDEX-API/domain/state/client"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 	"github.com/CoreumFoundation/coreum/v5/pkg/client"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
 )
 
 type cache struct {
@@ -38,6 +44,14 @@ type Application struct {
 	TxEncoder      map[metadata.Network]txClient
 	orderClient    ordergrpc.OrderServiceClient
 	currencyClient currency.Application
+	chainIDs       map[metadata.Network]string
+	chainIDMutex   *sync.RWMutex
+	sequences      *sequenceTracker
+	ticker         *ticker.Application
+	stateClient    stategrpc.StateServiceClient
+	heights        map[metadata.Network]*orderBookHeights
+	heightsMutex   *sync.Mutex
+	submitted      *cache // Transactions submitted through this server, see TX_PENDING_WINDOW
 }
 
 type txClient struct {
@@ -46,12 +60,6 @@ type txClient struct {
 	reader        *coreum.Reader
 }
 
-type WalletAsset struct {
-	Denom        string
-	Amount       string
-	SymbolAmount string
-}
-
 type OrderBookOrder struct {
 	OrderBookOrder *coreum.OrderBookOrder
 	BaseDenom      *denom.Denom
@@ -60,18 +68,22 @@ type OrderBookOrder struct {
 	Side           orderproperties.Side
 }
 
-func NewApplication(currencyClient *currency.Application) *Application {
+func NewApplication(currencyClient *currency.Application, tickerClient *ticker.Application) *Application {
 	orderbookClient := ordergrpcclient.Client()
-	return NewApplicationWithClients(orderbookClient, currencyClient)
+	return NewApplicationWithClients(orderbookClient, stateclient.Client(), currencyClient, tickerClient)
 }
 
-func NewApplicationWithClients(orderClient ordergrpc.OrderServiceClient,
-	currencyClient *currency.Application) *Application {
+func NewApplicationWithClients(orderClient ordergrpc.OrderServiceClient, stateClient stategrpc.StateServiceClient,
+	currencyClient *currency.Application, tickerClient *ticker.Application) *Application {
 
 	txEncoders := make(map[metadata.Network]txClient)
 	coreum.InitReaders()
 	nodeConnections := coreum.NewNodeConnections()
 	for network, clientCtx := range nodeConnections {
+		// Required to pack the DEX messages into unsigned transactions
+		dextypes.RegisterInterfaces(clientCtx.InterfaceRegistry())
+		// Required to unpack vesting accounts (locked balances of the wallet)
+		vestingtypes.RegisterInterfaces(clientCtx.InterfaceRegistry())
 		txFactory := client.Factory{}.
 			WithKeybase(clientCtx.Keyring()).
 			WithChainID(clientCtx.ChainID()).
@@ -89,7 +101,13 @@ func NewApplicationWithClients(orderClient ordergrpc.OrderServiceClient,
 		data:  make(map[string]*dmncache.LockableCache),
 	}
 	go dmncache.CleanCache(orderbookCache.data, orderbookCache.mutex, 15*time.Minute)
-	return &Application{txEncoders, orderClient, *currencyClient}
+	submittedCache := &cache{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}
+	go dmncache.CleanCache(submittedCache.data, submittedCache.mutex, TX_PENDING_WINDOW)
+	return &Application{txEncoders, orderClient, *currencyClient, make(map[metadata.Network]string), &sync.RWMutex{}, newSequenceTracker(), tickerClient,
+		stateClient, make(map[metadata.Network]*orderBookHeights), &sync.Mutex{}, submittedCache}
 }
 
 func (a *Application) EncodeTx(network metadata.Network, from sdk.AccAddress, msgs ...sdk.Msg) ([]byte, error) {
@@ -111,11 +129,22 @@ func (a *Application) EncodeTx(network metadata.Network, from sdk.AccAddress, ms
 	return encoder(unsignedTx.GetTx())
 }
 
-func (a *Application) SubmitTx(network metadata.Network, rawTx []byte) (*sdk.TxResponse, error) {
-	return client.BroadcastRawTx(context.Background(), a.TxEncoder[network].clientContext, rawTx)
+// AccountSequence returns the sequence to sign a new transaction of the account with.
+// Sequences handed out for transactions which are not yet included in a block are skipped, so that several
+// transactions can be created for the account within a single block (see sequenceTracker).
+func (a *Application) AccountSequence(network metadata.Network, address string) (uint64, error) {
+	acc, err := a.account(network, address)
+	if err != nil {
+		return 0, err
+	}
+	key, err := sequenceKey(network, address)
+	if err != nil {
+		return 0, err
+	}
+	return a.sequences.next(key, acc.GetSequence(), time.Now()), nil
 }
 
-func (a *Application) AccountSequence(network metadata.Network, address string) (uint64, error) {
+func (a *Application) account(network metadata.Network, address string) (sdk.AccountI, error) {
 	clientCtx := a.TxEncoder[network].clientContext
 
 	req := &authtypes.QueryAccountRequest{
@@ -126,35 +155,25 @@ func (a *Application) AccountSequence(network metadata.Network, address string)
 	res, err := authQueryClient.Account(ctx, req)
 	if err != nil {
 		logger.Errorf("Error querying account %s: %v", address, err)
-		return 0, err
+		return nil, err
 	}
 
 	var acc sdk.AccountI
 	if err := clientCtx.InterfaceRegistry().UnpackAny(res.Account, &acc); err != nil {
 		logger.Errorf("Error unpacking account: %v", err)
-		return 0, err
+		return nil, err
 	}
 
-	return acc.GetSequence(), nil
+	return acc, nil
 }
 
 func (a *Application) OrderBookRelevantOrders(network metadata.Network, denom1, denom2 string, limit int, aggregate bool) (*coreum.OrderBookOrders, error) {
 	var err error
-	orderbook, err := a.fetchOrderBookFromChain(network, denom1, denom2, limit)
+	orderbook, err := a.fetchOrderBook(network, denom1, denom2, limit)
 	if err != nil {
 		return nil, err
 	}
-	// Order the buys and sales descending
-	sort.Slice(orderbook.Buy, func(i, j int) bool {
-		p1, _ := dec.NewFromString(orderbook.Buy[i].Price)
-		p2, _ := dec.NewFromString(orderbook.Buy[j].Price)
-		return p1.GreaterThan(p2)
-	})
-	sort.Slice(orderbook.Sell, func(i, j int) bool {
-		p1, _ := dec.NewFromString(orderbook.Sell[i].Price)
-		p2, _ := dec.NewFromString(orderbook.Sell[j].Price)
-		return p1.GreaterThan(p2)
-	})
+	sortOrderBook(orderbook)
 	if aggregate {
 		// Clone the orderbook so that the original orderbook is not modified
 		orderbookClone := &coreum.OrderBookOrders{
@@ -179,26 +198,47 @@ func (a *Application) OrderBookRelevantOrders(network metadata.Network, denom1,
 	return orderbook, nil
 }
 
-func (a *Application) fetchOrderBookFromChain(network metadata.Network, denom1, denom2 string, limit int) (*coreum.OrderBookOrders, error) {
+func (a *Application) fetchOrderBook(network metadata.Network, denom1, denom2 string, limit int) (*coreum.OrderBookOrders, error) {
 	ctx, timeout := context.WithTimeout(context.Background(), 60*time.Second)
 	defer timeout()
 
 	var err error
-	orderbook, err := a.TxEncoder[network].reader.QueryOrderBookRelevantOrders(ctx, denom1, denom2, uint64(limit))
+	orderbook, err := a.relevantOrders(ctx, network, denom1, denom2, limit)
 	if err != nil {
 		if strings.Contains(err.Error(), "record not found") {
 			return nil, fmt.Errorf("there is no orderbook for %s - %s", denom1, denom2)
 		}
 		return nil, err
 	}
-	// The orders from the on chain orderbook need to be normalized to the orderbook format
+	if err := a.normalizeOrderBook(ctx, network, denom1, denom2, orderbook); err != nil {
+		return nil, err
+	}
+	return orderbook, nil
+}
+
+// Order the buys and sales descending
+func sortOrderBook(orderbook *coreum.OrderBookOrders) {
+	sort.Slice(orderbook.Buy, func(i, j int) bool {
+		p1, _ := dec.NewFromString(orderbook.Buy[i].Price)
+		p2, _ := dec.NewFromString(orderbook.Buy[j].Price)
+		return p1.GreaterThan(p2)
+	})
+	sort.Slice(orderbook.Sell, func(i, j int) bool {
+		p1, _ := dec.NewFromString(orderbook.Sell[i].Price)
+		p2, _ := dec.NewFromString(orderbook.Sell[j].Price)
+		return p1.GreaterThan(p2)
+	})
+}
+
+// normalizeOrderBook applies the precisions of the currencies to the orders of the on chain orderbook denom1/denom2
+func (a *Application) normalizeOrderBook(ctx context.Context, network metadata.Network, denom1, denom2 string, orderbook *coreum.OrderBookOrders) error {
 	denom1Currency, err := a.currencyClient.GetCurrency(ctx, network, denom1)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	denom2Currency, err := a.currencyClient.GetCurrency(ctx, network, denom2)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	for _, order := range orderbook.Buy {
@@ -221,7 +261,7 @@ func (a *Application) fetchOrderBookFromChain(network metadata.Network, denom1,
 		order.Amount = o.Amount
 		order.Price = o.Price
 	}
-	return orderbook, nil
+	return nil
 }
 
 func aggregateOrders(orders []*coreum.OrderBookOrder) []*coreum.OrderBookOrder {
@@ -275,67 +315,154 @@ func aggregateOrders(orders []*coreum.OrderBookOrder) []*coreum.OrderBookOrder {
 	return aggregatedOrders
 }
 
+// OrderBookRelevantOrdersForAccount returns all open orders of the account in the orderbook denom1/denom2 (including
+// the orders in the opposite orderbook), independent of their depth in the orderbook.
 func (a *Application) OrderBookRelevantOrdersForAccount(network metadata.Network, denom1, denom2, account string) (*coreum.OrderBookOrders, error) {
-	relevantOrdersForAllAccounts, err := a.OrderBookRelevantOrders(network, denom1, denom2, 100, false) // Get more orders so that we can retrieve all potential orders for this account
+	ctx, timeout := context.WithTimeout(context.Background(), 60*time.Second)
+	defer timeout()
+
+	orderbook, err := a.TxEncoder[network].reader.QueryOrderBookOrdersForCreator(ctx, account, denom1, denom2)
 	if err != nil {
 		return nil, err
 	}
-	// Iterate over all orders and filter out the orders for the account
-	relevantOrdersForAccount := &coreum.OrderBookOrders{
-		Buy:  make([]*coreum.OrderBookOrder, 0),
-		Sell: make([]*coreum.OrderBookOrder, 0),
+	if err := a.normalizeOrderBook(ctx, network, denom1, denom2, orderbook); err != nil {
+		return nil, err
 	}
-	for _, order := range relevantOrdersForAllAccounts.Buy {
-		if order.Account == account {
-			relevantOrdersForAccount.Buy = append(relevantOrdersForAccount.Buy, order)
-		}
+	sortOrderBook(orderbook)
+	return orderbook, nil
+}
+
+// OrderBooksForAccount returns all open orders of the account over all markets, by symbol ({base denom}_{quote denom}
+// of the orders as placed). Markets of which the orders can not be normalized are skipped.
+func (a *Application) OrderBooksForAccount(network metadata.Network, account string) (map[string]*coreum.OrderBookOrders, error) {
+	ctx, timeout := context.WithTimeout(context.Background(), 60*time.Second)
+	defer timeout()
+
+	orderbooks, err := a.TxEncoder[network].reader.QueryOrderBooksForCreator(ctx, account)
+	if err != nil {
+		return nil, err
 	}
-	for _, order := range relevantOrdersForAllAccounts.Sell {
-		if order.Account == account {
-			relevantOrdersForAccount.Sell = append(relevantOrdersForAccount.Sell, order)
+	for symbol, orderbook := range orderbooks {
+		denoms := strings.SplitN(symbol, "_", 2)
+		// A market with an unknown currency does not prevent returning the other markets. It is left out instead of
+		// returned with amounts which are not normalized like the amounts of the other markets.
+		if err := a.normalizeOrderBook(ctx, network, denoms[0], denoms[1], orderbook); err != nil {
+			logger.Errorf("Error normalizing orders of %s: %v", symbol, err)
+			delete(orderbooks, symbol)
+			continue
 		}
+		sortOrderBook(orderbook)
 	}
-	return relevantOrdersForAccount, nil
+	return orderbooks, nil
+}
 
+// OpenOrder returns an open order of the account by its order ID as known on chain. OrderNotFound tells a missing order
+// apart from other errors.
+func (a *Application) OpenOrder(ctx context.Context, network metadata.Network, account, orderID string) (*dextypes.Order, error) {
+	return a.TxEncoder[network].reader.QueryOrder(ctx, account, orderID)
 }
 
-func (a *Application) WalletAssets(network metadata.Network, address string) ([]WalletAsset, error) {
-	coins := sdk.Coins{}
-	bankClient := banktypes.NewQueryClient(a.TxEncoder[network].clientContext)
-	var paginationKey []byte = nil
-	for {
-		res, err := bankClient.AllBalances(context.Background(), &banktypes.QueryAllBalancesRequest{
-			Address:      address,
-			Pagination:   &query.PageRequest{Key: paginationKey},
-			ResolveDenom: false,
-		})
-		if err != nil {
-			return nil, err
-		}
-		coins = coins.Add(res.Balances...)
-		paginationKey = res.Pagination.NextKey
-		if paginationKey == nil {
-			break
+// OrderIDExists checks if the account already used the order ID, either for an order known by the data-aggregator
+// or for an open order on chain (which the data-aggregator might not have processed yet).
+func (a *Application) OrderIDExists(ctx context.Context, network metadata.Network, account, orderID string) (bool, error) {
+	orders, err := a.orderClient.GetAll(ordergrpcclient.AuthCtx(ctx), &ordergrpc.Filter{
+		Network: network,
+		Account: &account,
+		OrderID: &orderID,
+		Limit:   lo.ToPtr(int32(1)),
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(orders.Orders) > 0 {
+		return true, nil
+	}
+	_, err = a.OpenOrder(ctx, network, account, orderID)
+	switch {
+	case err == nil:
+		return true, nil
+	case OrderNotFound(err):
+		return false, nil
+	}
+	return false, err
+}
+
+// OrderNotFound returns true if the error of an order query on chain is the order not being found. The DEX module
+// reports it as its record not found error, which is only recognizable by its message over gRPC.
+func OrderNotFound(err error) bool {
+	return status.Code(err) == codes.NotFound || strings.Contains(err.Error(), dextypes.ErrRecordNotFound.Error())
+}
+
+// OpenOrders returns the open orders of the account as known on chain.
+// If denom1 and denom2 are provided only the orders in that market (in either direction) are returned.
+func (a *Application) OpenOrders(network metadata.Network, account, denom1, denom2 string) ([]dextypes.Order, error) {
+	orders, err := a.TxEncoder[network].reader.QueryOrdersByCreator(context.Background(), account)
+	if err != nil {
+		return nil, err
+	}
+	if denom1 == "" && denom2 == "" {
+		return orders, nil
+	}
+	marketOrders := make([]dextypes.Order, 0)
+	for _, order := range orders {
+		if (order.BaseDenom == denom1 && order.QuoteDenom == denom2) ||
+			(order.BaseDenom == denom2 && order.QuoteDenom == denom1) {
+			marketOrders = append(marketOrders, order)
 		}
 	}
-	// Transform the coins to WalletAsset and add the symbol amount (apply precision)
-	walletAssets := make([]WalletAsset, 0)
-	for _, coin := range coins {
-		denomCurrency, err := a.currencyClient.GetCurrency(context.Background(), network, coin.Denom)
+	return marketOrders, nil
+}
+
+type Orders []*dmn.Order
+
+// OrdersPage is a page of orders with the cursor to retrieve the next page (nil if there are no more orders)
+type OrdersPage struct {
+	Orders     Orders
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// GetOrdersPage returns the order history for the given filter and the cursor for the next page.
+// The orders are normalized so that the amounts and prices are human readable.
+func (a *Application) GetOrdersPage(ctx context.Context, filter *ordergrpc.Filter) (*OrdersPage, error) {
+	orders, err := a.orderClient.GetAll(ordergrpcclient.AuthCtx(ctx), filter)
+	if err != nil {
+		return nil, err
+	}
+	ords := Orders(make([]*dmn.Order, 0))
+	for _, order := range orders.Orders {
+		o, err := a.normalizeOrder(ctx, order)
 		if err != nil {
-			return walletAssets, err
-		}
-		precision := int32(0)
-		if denomCurrency.Denom != nil && denomCurrency.Denom.Precision != nil {
-			precision = *denomCurrency.Denom.Precision
+			logger.Errorf("Error normalizing order %d: %v", order.Sequence, err)
+			continue
 		}
-		walletAssets = append(walletAssets, WalletAsset{
-			Denom:        coin.Denom,
-			Amount:       coin.Amount.String(),
-			SymbolAmount: dec.NewFromBigInt(coin.Amount.BigInt(), 0).Div(dec.New(1, precision)).String(),
-		})
+		ords = append(ords, o)
+	}
+	return &OrdersPage{Orders: ords, NextCursor: orders.NextCursor}, nil
+}
+
+// GetOrder returns a single order by its sequence
+func (a *Application) GetOrder(ctx context.Context, network metadata.Network, sequence int64) (*dmn.Order, error) {
+	order, err := a.orderClient.Get(ordergrpcclient.AuthCtx(ctx), &ordergrpc.ID{
+		Network:  network,
+		Sequence: sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.normalizeOrder(ctx, order)
+}
+
+func (a *Application) normalizeOrder(ctx context.Context, order *ordergrpc.Order) (*dmn.Order, error) {
+	o, err := a.Normalize(ctx, order)
+	if err != nil {
+		return nil, err
 	}
-	return walletAssets, nil
+	return &dmn.Order{
+		Order:                 order,
+		HumanReadablePrice:    o.HumanReadablePrice,
+		SymbolAmount:          o.SymbolAmount,
+		RemainingSymbolAmount: o.RemainingSymbolAmount,
+	}, nil
 }
 
 // Normalize order to have the precision of the currencies applied
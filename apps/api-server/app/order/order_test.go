@@ -82,7 +82,7 @@ func newApplicationMock(t *testing.T) *Application {
 		t.Fatal(err)
 	}
 	currencyApp := currencyapp.NewApplication(currencyService)
-	return NewApplication(currencyApp)
+	return NewApplication(currencyApp, nil)
 }
 
 func Test_NormalizeOrder(t *testing.T) {
@@ -0,0 +1,172 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	ordergrpcclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	stategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/state"
+	stateclient "github.com/CoreumFoundation/CoreDEX-API/domain/state/client"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+const (
+	// ORDERBOOK_MAX_LAG is the number of blocks the order books in the store may be behind the chain. The order books of
+	// a data-aggregator which is further behind (catching up or not processing blocks) are not used.
+	ORDERBOOK_MAX_LAG = 5
+	// HEIGHTS_CACHE is how long the heights of the chain and of the order books in the store are cached (a block time)
+	HEIGHTS_CACHE = time.Second
+)
+
+// orderBookHeights are the heights to judge whether the order books in the store are current
+type orderBookHeights struct {
+	processed int64 // Height up to which the data-aggregator maintained the order books in the store
+	head      int64 // Latest height of the chain
+	at        time.Time
+}
+
+// relevantOrders returns the orders of the order book denom1/denom2 (including the opposite order book) around the
+// spread, without the precisions applied. The order books maintained by the data-aggregator are used, the chain is
+// only queried if the store does not hold a recent order book.
+func (a *Application) relevantOrders(ctx context.Context, network metadata.Network, denom1, denom2 string, limit int) (*coreum.OrderBookOrders, error) {
+	orderbook, err := a.storedRelevantOrders(ctx, network, denom1, denom2, limit)
+	if err == nil {
+		return orderbook, nil
+	}
+	logger.Debugf("Order book %s/%s not available from the store, querying the chain: %v", denom1, denom2, err)
+	return a.TxEncoder[network].reader.QueryOrderBookRelevantOrders(ctx, denom1, denom2, uint64(limit))
+}
+
+func (a *Application) storedRelevantOrders(ctx context.Context, network metadata.Network, denom1, denom2 string, limit int) (*coreum.OrderBookOrders, error) {
+	if err := a.orderBooksCurrent(ctx, network); err != nil {
+		return nil, err
+	}
+	orders := make([]dextypes.Order, 0)
+	found := false
+	for _, denoms := range [][2]string{{denom1, denom2}, {denom2, denom1}} {
+		orderBook, err := a.orderClient.GetOrderBook(ordergrpcclient.AuthCtx(ctx), &ordergrpc.OrderBookID{
+			Network:    network,
+			BaseDenom:  denoms[0],
+			QuoteDenom: denoms[1],
+		})
+		if err != nil {
+			// Only one of the order books has to exist
+			continue
+		}
+		if orderBook.ReconciledAt == nil {
+			return nil, fmt.Errorf("order book %s/%s is not reconciled", denoms[0], denoms[1])
+		}
+		found = true
+		o, err := storedOrders(orderBook.BaseDenom, orderBook.QuoteDenom, orderBook.Buy, orderBook.Sell)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o...)
+	}
+	if !found {
+		return nil, fmt.Errorf("order book %s/%s not found", denom1, denom2)
+	}
+	orderbook, err := coreum.NewOrderBookOrders(orders, denom1, denom2)
+	if err != nil {
+		return nil, err
+	}
+	if len(orderbook.Sell) > limit {
+		orderbook.Sell = orderbook.Sell[:limit]
+	}
+	if len(orderbook.Buy) > limit {
+		orderbook.Buy = orderbook.Buy[:limit]
+	}
+	return orderbook, nil
+}
+
+// orderBooksCurrent returns an error if the order books in the store are more than ORDERBOOK_MAX_LAG blocks behind the
+// chain. An order book is only stored when it changes, so the height up to which the data-aggregator processed the
+// order books (stored for every block) is compared instead of the height of the order book.
+func (a *Application) orderBooksCurrent(ctx context.Context, network metadata.Network) error {
+	heights, err := a.orderBookHeights(ctx, network)
+	if err != nil {
+		return err
+	}
+	if heights.processed < heights.head-ORDERBOOK_MAX_LAG {
+		return fmt.Errorf("order books are at height %d, the chain at %d", heights.processed, heights.head)
+	}
+	return nil
+}
+
+func (a *Application) orderBookHeights(ctx context.Context, network metadata.Network) (*orderBookHeights, error) {
+	a.heightsMutex.Lock()
+	defer a.heightsMutex.Unlock()
+	if heights, ok := a.heights[network]; ok && time.Since(heights.at) < HEIGHTS_CACHE {
+		return heights, nil
+	}
+	state, err := a.stateClient.Get(stateclient.AuthCtx(ctx), &stategrpc.StateQuery{
+		Network:   network,
+		StateType: stategrpc.StateType_ORDERBOOK_HEIGHT,
+	})
+	if err != nil {
+		return nil, err
+	}
+	processed := struct{ Height int64 }{}
+	if err := json.Unmarshal([]byte(state.Content), &processed); err != nil {
+		return nil, fmt.Errorf("no order book height stored: %w", err)
+	}
+	status, err := a.TxEncoder[network].clientContext.RPCClient().Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	heights := &orderBookHeights{
+		processed: processed.Height,
+		head:      status.SyncInfo.LatestBlockHeight,
+		at:        time.Now(),
+	}
+	a.heights[network] = heights
+	return heights, nil
+}
+
+// storedOrders converts the orders of an order book (or snapshot) in the store into chain orders
+func storedOrders(baseDenom, quoteDenom string, buy, sell []*ordergrpc.OrderBookOrder) ([]dextypes.Order, error) {
+	orders := make([]dextypes.Order, 0, len(buy)+len(sell))
+	sides := []struct {
+		side   dextypes.Side
+		orders []*ordergrpc.OrderBookOrder
+	}{
+		{dextypes.SIDE_BUY, buy},
+		{dextypes.SIDE_SELL, sell},
+	}
+	for _, s := range sides {
+		for _, o := range s.orders {
+			price, err := dextypes.NewPriceFromString(o.Price)
+			if err != nil {
+				return nil, fmt.Errorf("invalid price %s of order %d: %w", o.Price, o.Sequence, err)
+			}
+			quantity, ok := sdkmath.NewIntFromString(o.Quantity)
+			if !ok {
+				return nil, fmt.Errorf("invalid quantity %s of order %d", o.Quantity, o.Sequence)
+			}
+			remaining, ok := sdkmath.NewIntFromString(o.RemainingQuantity)
+			if !ok {
+				return nil, fmt.Errorf("invalid remaining quantity %s of order %d", o.RemainingQuantity, o.Sequence)
+			}
+			orders = append(orders, dextypes.Order{
+				Creator:               o.Account,
+				ID:                    o.OrderID,
+				Sequence:              o.Sequence,
+				BaseDenom:             baseDenom,
+				QuoteDenom:            quoteDenom,
+				Price:                 &price,
+				Quantity:              quantity,
+				RemainingBaseQuantity: remaining,
+				Side:                  s.side,
+			})
+		}
+	}
+	return orders, nil
+}
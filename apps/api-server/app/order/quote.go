@@ -0,0 +1,165 @@
+package order
+
+import (
+	"context"
+
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+)
+
+// QUOTE_DEPTH is the number of orders per side of the order book which is used to calculate a quote.
+// A quantity which can not be filled within these orders is reported as not sufficient.
+const QUOTE_DEPTH = 100
+
+// Quote is the estimated execution of a market order against the current order book.
+// Quantities are in the base denom, prices and amounts in the quote denom, all with the precision applied.
+type Quote struct {
+	Symbol          string
+	Side            orderproperties.Side // Side of the order for which the quote is calculated
+	Quantity        string               // Requested quantity
+	FilledQuantity  string               // Quantity which can be filled by the order book
+	QuoteAmount     string               // Amount paid (buy) or received (sell) for the filled quantity
+	BestPrice       string               // Price of the first order which would be matched
+	AveragePrice    string               // Volume weighted price of the filled quantity
+	WorstPrice      string               // Price of the last order which would be matched
+	MidPrice        string               // Middle of the best buy and sell price, empty if one side of the order book is empty
+	PriceImpact     string               // Difference between the average and the mid price in percent (positive is unfavorable)
+	LimitPrice      string               `json:",omitempty"`
+	FillableAtLimit string               `json:",omitempty"` // Quantity which can be filled at the limit price or better
+	DepthSufficient bool                 // The order book holds enough orders to fill the requested quantity
+}
+
+// quoteLevel is an order in the order book with the precisions applied
+type quoteLevel struct {
+	price    dec.Decimal
+	quantity dec.Decimal
+}
+
+// quoteResult is the result of walking the order book
+type quoteResult struct {
+	filled          dec.Decimal
+	quoteAmount     dec.Decimal
+	best            *dec.Decimal
+	worst           *dec.Decimal
+	fillableAtLimit dec.Decimal
+}
+
+// Quote estimates the execution of a market order of the given side and quantity (base denom, precision applied) in the
+// order book of the symbol. The limit price (quote denom, precision applied) is optional.
+func (a *Application) Quote(ctx context.Context, network metadata.Network, sym *symbol.Symbol, side orderproperties.Side,
+	quantity dec.Decimal, limitPrice *dec.Decimal) (*Quote, error) {
+	orderbook, err := a.OrderBookRelevantOrders(network, sym.Denom1.Denom, sym.Denom2.Denom, QUOTE_DEPTH, false)
+	if err != nil {
+		return nil, err
+	}
+	basePrecision, quotePrecision, err := a.currencyClient.Precisions(ctx, network, sym.Denom1, sym.Denom2)
+	if err != nil {
+		return nil, err
+	}
+	asks := quoteLevels(orderbook.Sell, basePrecision, quotePrecision)
+	bids := quoteLevels(orderbook.Buy, basePrecision, quotePrecision)
+	// The sells are ordered descending, the best ask is the lowest price
+	for i, j := 0, len(asks)-1; i < j; i, j = i+1, j-1 {
+		asks[i], asks[j] = asks[j], asks[i]
+	}
+
+	levels := asks
+	if side == orderproperties.Side_SIDE_SELL {
+		levels = bids
+	}
+	res := walkOrderBook(levels, side, quantity, limitPrice)
+
+	q := &Quote{
+		Symbol:          sym.Denom1.Denom + "_" + sym.Denom2.Denom,
+		Side:            side,
+		Quantity:        quantity.String(),
+		FilledQuantity:  res.filled.String(),
+		QuoteAmount:     res.quoteAmount.String(),
+		DepthSufficient: res.filled.Equal(quantity),
+	}
+	if limitPrice != nil {
+		q.LimitPrice = limitPrice.String()
+		q.FillableAtLimit = res.fillableAtLimit.String()
+	}
+	if res.best == nil {
+		return q, nil
+	}
+	average := res.quoteAmount.Div(res.filled)
+	q.BestPrice = res.best.String()
+	q.WorstPrice = res.worst.String()
+	q.AveragePrice = average.String()
+	if len(asks) > 0 && len(bids) > 0 {
+		mid := asks[0].price.Add(bids[0].price).Div(dec.NewFromInt(2))
+		q.MidPrice = mid.String()
+		q.PriceImpact = priceImpact(side, average, mid).String()
+	}
+	return q, nil
+}
+
+// quoteLevels applies the precisions to the remaining quantities and the prices of the orders
+func quoteLevels(orders []*coreum.OrderBookOrder, basePrecision, quotePrecision int32) []quoteLevel {
+	levels := make([]quoteLevel, 0, len(orders))
+	for _, order := range orders {
+		remaining, err := dec.NewFromString(order.RemainingAmount)
+		if err != nil || !remaining.IsPositive() {
+			continue
+		}
+		levels = append(levels, quoteLevel{
+			price:    order.PriceDec.Mul(dec.New(1, basePrecision)).Div(dec.New(1, quotePrecision)),
+			quantity: remaining.Div(dec.New(1, basePrecision)),
+		})
+	}
+	return levels
+}
+
+// walkOrderBook fills the quantity against the levels (best price first).
+// fillableAtLimit is the part of the quantity which can be filled at the limit price or better.
+func walkOrderBook(levels []quoteLevel, side orderproperties.Side, quantity dec.Decimal, limitPrice *dec.Decimal) quoteResult {
+	res := quoteResult{
+		filled:          dec.Zero,
+		quoteAmount:     dec.Zero,
+		fillableAtLimit: dec.Zero,
+	}
+	for _, level := range levels {
+		if !res.filled.LessThan(quantity) {
+			break
+		}
+		fill := dec.Min(level.quantity, quantity.Sub(res.filled))
+		res.filled = res.filled.Add(fill)
+		res.quoteAmount = res.quoteAmount.Add(fill.Mul(level.price))
+		price := level.price
+		if res.best == nil {
+			res.best = &price
+		}
+		res.worst = &price
+		if limitPrice != nil && withinLimit(side, price, *limitPrice) {
+			res.fillableAtLimit = res.fillableAtLimit.Add(fill)
+		}
+	}
+	return res
+}
+
+// withinLimit checks if the price is at the limit price or better for the side of the order
+func withinLimit(side orderproperties.Side, price, limitPrice dec.Decimal) bool {
+	if side == orderproperties.Side_SIDE_SELL {
+		return price.GreaterThanOrEqual(limitPrice)
+	}
+	return price.LessThanOrEqual(limitPrice)
+}
+
+// priceImpact is the difference between the average price and the mid price in percent of the mid price.
+// Paying more (buy) or receiving less (sell) than the mid price is a positive impact.
+func priceImpact(side orderproperties.Side, average, mid dec.Decimal) dec.Decimal {
+	if mid.IsZero() {
+		return dec.Zero
+	}
+	diff := average.Sub(mid)
+	if side == orderproperties.Side_SIDE_SELL {
+		diff = diff.Neg()
+	}
+	return diff.Div(mid).Mul(dec.NewFromInt(100)).Round(4)
+}
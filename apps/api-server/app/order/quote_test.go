@@ -0,0 +1,64 @@
+package order
+
+import (
+	"testing"
+
+	dec "github.com/shopspring/decimal"
+
+	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
+)
+
+func Test_WalkOrderBook(t *testing.T) {
+	asks := []quoteLevel{
+		{price: dec.RequireFromString("1.0"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("1.1"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("1.5"), quantity: dec.RequireFromString("5")},
+	}
+	bids := []quoteLevel{
+		{price: dec.RequireFromString("0.9"), quantity: dec.RequireFromString("10")},
+		{price: dec.RequireFromString("0.8"), quantity: dec.RequireFromString("10")},
+	}
+	limit := dec.RequireFromString("1.1")
+	tests := []struct {
+		name            string
+		levels          []quoteLevel
+		side            orderproperties.Side
+		quantity        string
+		limit           *dec.Decimal
+		filled          string
+		quoteAmount     string
+		worst           string
+		fillableAtLimit string
+	}{
+		{"buy first level", asks, orderproperties.Side_SIDE_BUY, "5", nil, "5", "5", "1", "0"},
+		{"buy over levels", asks, orderproperties.Side_SIDE_BUY, "25", &limit, "25", "28.5", "1.5", "20"},
+		{"buy more than depth", asks, orderproperties.Side_SIDE_BUY, "30", nil, "25", "28.5", "1.5", "0"},
+		{"sell over levels", bids, orderproperties.Side_SIDE_SELL, "15", nil, "15", "13", "0.8", "0"},
+		{"sell with limit", bids, orderproperties.Side_SIDE_SELL, "15", &limit, "15", "13", "0.8", "0"},
+	}
+	for _, test := range tests {
+		res := walkOrderBook(test.levels, test.side, dec.RequireFromString(test.quantity), test.limit)
+		if res.filled.String() != test.filled {
+			t.Errorf("%s: filled %s, expected %s", test.name, res.filled, test.filled)
+		}
+		if res.quoteAmount.String() != test.quoteAmount {
+			t.Errorf("%s: quote amount %s, expected %s", test.name, res.quoteAmount, test.quoteAmount)
+		}
+		if res.worst == nil || res.worst.String() != test.worst {
+			t.Errorf("%s: worst price %v, expected %s", test.name, res.worst, test.worst)
+		}
+		if res.fillableAtLimit.String() != test.fillableAtLimit {
+			t.Errorf("%s: fillable at limit %s, expected %s", test.name, res.fillableAtLimit, test.fillableAtLimit)
+		}
+	}
+}
+
+func Test_PriceImpact(t *testing.T) {
+	mid := dec.RequireFromString("1")
+	if got := priceImpact(orderproperties.Side_SIDE_BUY, dec.RequireFromString("1.06"), mid); got.String() != "6" {
+		t.Errorf("buy: got %s, expected 6", got)
+	}
+	if got := priceImpact(orderproperties.Side_SIDE_SELL, dec.RequireFromString("0.86"), mid); got.String() != "14" {
+		t.Errorf("sell: got %s, expected 14", got)
+	}
+}
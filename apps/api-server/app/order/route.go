@@ -0,0 +1,266 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+const (
+	// ROUTE_MAX_HOPS is the maximum number of markets a route passes through
+	ROUTE_MAX_HOPS = 3
+	// ROUTE_MAX_CANDIDATES is the maximum number of paths which are simulated (shortest paths first)
+	ROUTE_MAX_CANDIDATES = 20
+	// ROUTE_DEPTH is the number of orders per order book used to simulate a hop
+	ROUTE_DEPTH = 100
+)
+
+// ErrNoRoute is returned when there is no path between the denoms, or none of the paths can fill any amount
+var ErrNoRoute = errors.New("no route")
+
+// RouteHop is a single conversion of a route, executed by an IOC buy order in the order book BaseDenom/QuoteDenom:
+// The QuoteDenom is paid and the BaseDenom is received. Amounts are in subunits, prices in QuoteDenom subunits per
+// BaseDenom subunit.
+type RouteHop struct {
+	BaseDenom    string
+	QuoteDenom   string
+	InputAmount  string // Expected amount of the QuoteDenom paid
+	OutputAmount string // Expected amount of the BaseDenom received (the quantity of the order)
+	AveragePrice string
+	LimitPrice   string // Price of the order: the worst price of the simulated fill, rounded to the price tick
+	Order        dextypes.MsgPlaceOrder
+}
+
+// Route is the best route to convert Amount of From into To.
+// The orders of the hops are placed in a single transaction, so either all hops are executed or none.
+type Route struct {
+	From           string
+	To             string
+	Amount         string // Amount of From to convert (subunits)
+	InputAmount    string // Expected amount of From paid (subunits), can be less than Amount due to the price ticks
+	ExpectedOutput string // Expected amount of To received (subunits)
+	Hops           []RouteHop
+}
+
+// routeLevel is an order which sells the base denom of a hop, price in quote subunits per base subunit
+type routeLevel struct {
+	price    dec.Decimal
+	quantity dec.Decimal
+}
+
+// hopPlan is the simulated execution of a hop
+type hopPlan struct {
+	quantity dec.Decimal // base received, multiple of the quantity step
+	spent    dec.Decimal // quote paid for the quantity
+	limit    dec.Decimal // limit price of the IOC order
+}
+
+// BestRoute searches the paths from one denom to the other through the order books on chain (up to ROUTE_MAX_HOPS
+// markets) and simulates converting the amount (subunits) over each path against the current orders, level by level.
+// The path with the highest output is returned with the IOC orders for the sender to execute it.
+func (a *Application) BestRoute(ctx context.Context, network metadata.Network, sender, from, to string, amount sdkmath.Int) (*Route, error) {
+	reader := a.TxEncoder[network].reader
+	orderBooks, err := reader.QueryAllOrderBooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := routePaths(orderBooks, from, to, ROUTE_MAX_HOPS, ROUTE_MAX_CANDIDATES)
+	if len(paths) == 0 {
+		return nil, ErrNoRoute
+	}
+	// The levels and params of the order books are shared between the paths
+	levels := make(map[string][]routeLevel)
+	params := make(map[string]*dextypes.QueryOrderBookParamsResponse)
+	var best *Route
+	var bestOutput dec.Decimal
+	for _, path := range paths {
+		route, output, err := a.simulateRoute(ctx, network, path, dec.NewFromBigInt(amount.BigInt(), 0), levels, params)
+		if err != nil {
+			return nil, err
+		}
+		if route == nil || (best != nil && !output.GreaterThan(bestOutput)) {
+			continue
+		}
+		best, bestOutput = route, output
+	}
+	if best == nil {
+		return nil, ErrNoRoute
+	}
+	best.Amount = amount.String()
+	for i := range best.Hops {
+		best.Hops[i].Order.Sender = sender
+	}
+	return best, nil
+}
+
+// simulateRoute simulates the hops of the path. The route is nil if any hop can not be filled.
+func (a *Application) simulateRoute(ctx context.Context, network metadata.Network, path []string, amount dec.Decimal,
+	levels map[string][]routeLevel, params map[string]*dextypes.QueryOrderBookParamsResponse) (*Route, dec.Decimal, error) {
+	route := &Route{
+		From: path[0],
+		To:   path[len(path)-1],
+		Hops: make([]RouteHop, 0, len(path)-1),
+	}
+	budget := amount
+	for i := 0; i < len(path)-1; i++ {
+		quoteDenom, baseDenom := path[i], path[i+1]
+		key := baseDenom + "_" + quoteDenom
+		if _, ok := levels[key]; !ok {
+			orderbook, err := a.relevantOrders(ctx, network, baseDenom, quoteDenom, ROUTE_DEPTH)
+			if err != nil {
+				return nil, dec.Zero, err
+			}
+			levels[key] = routeLevels(orderbook.Sell)
+			p, err := a.TxEncoder[network].reader.QueryOrderBookParams(ctx, baseDenom, quoteDenom)
+			if err != nil {
+				return nil, dec.Zero, err
+			}
+			params[key] = p
+		}
+		priceTick := dec.NewFromBigRat(params[key].PriceTick.Rat(), 18)
+		quantityStep := dec.NewFromBigInt(params[key].QuantityStep.BigInt(), 0)
+		plan, ok := planHop(levels[key], budget, priceTick, quantityStep)
+		if !ok {
+			return nil, dec.Zero, nil
+		}
+		limitPrice, err := coreum.ParsePrice(plan.limit.String())
+		if err != nil {
+			return nil, dec.Zero, fmt.Errorf("invalid limit price %s: %w", plan.limit, err)
+		}
+		if i == 0 {
+			route.InputAmount = plan.spent.Ceil().String()
+		}
+		route.Hops = append(route.Hops, RouteHop{
+			BaseDenom:    baseDenom,
+			QuoteDenom:   quoteDenom,
+			InputAmount:  plan.spent.Ceil().String(),
+			OutputAmount: plan.quantity.String(),
+			AveragePrice: plan.spent.Div(plan.quantity).String(),
+			LimitPrice:   plan.limit.String(),
+			Order: dextypes.MsgPlaceOrder{
+				Type:        dextypes.ORDER_TYPE_LIMIT,
+				ID:          uuid.New().String(),
+				BaseDenom:   baseDenom,
+				QuoteDenom:  quoteDenom,
+				Price:       &limitPrice,
+				Quantity:    sdkmath.NewIntFromBigInt(plan.quantity.BigInt()),
+				Side:        dextypes.SIDE_BUY,
+				TimeInForce: dextypes.TIME_IN_FORCE_IOC,
+			},
+		})
+		// The output of the hop is the input of the next hop
+		budget = plan.quantity
+	}
+	route.ExpectedOutput = budget.String()
+	return route, budget, nil
+}
+
+// routeLevels converts the sell orders (ascending by price) into levels
+func routeLevels(orders []*coreum.OrderBookOrder) []routeLevel {
+	levels := make([]routeLevel, 0, len(orders))
+	for _, order := range orders {
+		remaining, err := dec.NewFromString(order.RemainingAmount)
+		if err != nil || !remaining.IsPositive() || !order.PriceDec.IsPositive() {
+			continue
+		}
+		levels = append(levels, routeLevel{price: order.PriceDec, quantity: remaining})
+	}
+	return levels
+}
+
+// planHop simulates buying the base denom with the budget (quote denom) against the levels (best price first).
+// The limit price is the worst price reached, rounded up to the price tick. The quantity is limited so that the funds
+// the DEX locks for the order (quantity * limit price) do not exceed the budget, and rounded down to the quantity step.
+// Returns false if no quantity can be bought.
+func planHop(levels []routeLevel, budget, priceTick, quantityStep dec.Decimal) (hopPlan, bool) {
+	var worst dec.Decimal
+	bought := dec.Zero
+	remaining := budget
+	for _, level := range levels {
+		if !remaining.IsPositive() {
+			break
+		}
+		take := dec.Min(level.quantity, remaining.Div(level.price))
+		if !take.IsPositive() {
+			break
+		}
+		bought = bought.Add(take)
+		remaining = remaining.Sub(take.Mul(level.price))
+		worst = level.price
+	}
+	if !bought.IsPositive() {
+		return hopPlan{}, false
+	}
+	limit := worst
+	if priceTick.IsPositive() {
+		limit = worst.Div(priceTick).Ceil().Mul(priceTick)
+	}
+	quantity := dec.Min(bought, budget.Div(limit)).Floor()
+	if quantityStep.IsPositive() {
+		quantity = quantity.Div(quantityStep).Floor().Mul(quantityStep)
+	}
+	if !quantity.IsPositive() {
+		return hopPlan{}, false
+	}
+	// The cost of the rounded quantity, walking the same levels
+	spent := dec.Zero
+	left := quantity
+	for _, level := range levels {
+		if !left.IsPositive() {
+			break
+		}
+		take := dec.Min(level.quantity, left)
+		spent = spent.Add(take.Mul(level.price))
+		left = left.Sub(take)
+	}
+	return hopPlan{quantity: quantity, spent: spent, limit: limit}, true
+}
+
+// routePaths returns the paths (lists of denoms) from one denom to the other over the order books with at most
+// maxHops markets, shortest paths first. Every order book can be used in both directions.
+func routePaths(orderBooks []dextypes.OrderBookData, from, to string, maxHops, maxPaths int) [][]string {
+	neighbours := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, ob := range orderBooks {
+		for _, pair := range [][2]string{{ob.BaseDenom, ob.QuoteDenom}, {ob.QuoteDenom, ob.BaseDenom}} {
+			if seen[pair[0]+"_"+pair[1]] {
+				continue
+			}
+			seen[pair[0]+"_"+pair[1]] = true
+			neighbours[pair[0]] = append(neighbours[pair[0]], pair[1])
+		}
+	}
+	paths := make([][]string, 0)
+	// Breadth first, so that the paths are ordered by length
+	queue := [][]string{{from}}
+	for len(queue) > 0 && len(paths) < maxPaths {
+		path := queue[0]
+		queue = queue[1:]
+		last := path[len(path)-1]
+		if last == to {
+			paths = append(paths, path)
+			continue
+		}
+		if len(path) > maxHops {
+			continue
+		}
+		for _, next := range neighbours[last] {
+			if lo.Contains(path, next) {
+				continue
+			}
+			p := make([]string, len(path), len(path)+1)
+			copy(p, path)
+			queue = append(queue, append(p, next))
+		}
+	}
+	return paths
+}
@@ -0,0 +1,74 @@
+package order
+
+import (
+	"reflect"
+	"testing"
+
+	dec "github.com/shopspring/decimal"
+
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+func Test_PlanHop(t *testing.T) {
+	levels := []routeLevel{
+		{price: dec.RequireFromString("2"), quantity: dec.RequireFromString("100")},
+		{price: dec.RequireFromString("2.5"), quantity: dec.RequireFromString("100")},
+	}
+	tests := []struct {
+		name         string
+		budget       string
+		priceTick    string
+		quantityStep string
+		ok           bool
+		quantity     string
+		spent        string
+		limit        string
+	}{
+		{"single level", "100", "0.1", "1", true, "50", "100", "2"},
+		// 200 buys 100 at 2, the remaining 100 buys 40 at 2.5. The funds locked at the limit price of 2.5 limit the
+		// quantity to 300/2.5=120
+		{"over levels", "300", "0.1", "1", true, "120", "250", "2.5"},
+		{"quantity step", "100", "0.1", "1000", false, "", "", ""},
+		{"price tick", "100", "3", "1", true, "33", "66", "3"},
+		{"empty budget", "0", "0.1", "1", false, "", "", ""},
+	}
+	for _, test := range tests {
+		plan, ok := planHop(levels, dec.RequireFromString(test.budget), dec.RequireFromString(test.priceTick), dec.RequireFromString(test.quantityStep))
+		if ok != test.ok {
+			t.Errorf("%s: ok %v, expected %v", test.name, ok, test.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if plan.quantity.String() != test.quantity || plan.spent.String() != test.spent || plan.limit.String() != test.limit {
+			t.Errorf("%s: got quantity %s, spent %s, limit %s, expected %s, %s, %s", test.name,
+				plan.quantity, plan.spent, plan.limit, test.quantity, test.spent, test.limit)
+		}
+	}
+}
+
+func Test_RoutePaths(t *testing.T) {
+	orderBooks := []dextypes.OrderBookData{
+		{BaseDenom: "rwa", QuoteDenom: "ucore"},
+		{BaseDenom: "ucore", QuoteDenom: "rwa"},
+		{BaseDenom: "ucore", QuoteDenom: "usdc"},
+		{BaseDenom: "rwa", QuoteDenom: "eth"},
+		{BaseDenom: "eth", QuoteDenom: "btc"},
+		{BaseDenom: "btc", QuoteDenom: "usdc"},
+	}
+	got := routePaths(orderBooks, "rwa", "usdc", 3, 10)
+	expected := [][]string{
+		{"rwa", "ucore", "usdc"},
+		{"rwa", "eth", "btc", "usdc"},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+	if got := routePaths(orderBooks, "rwa", "usdc", 2, 10); len(got) != 1 {
+		t.Errorf("max 2 hops: got %v", got)
+	}
+	if got := routePaths(orderBooks, "rwa", "unknown", 3, 10); len(got) != 0 {
+		t.Errorf("no route: got %v", got)
+	}
+}
@@ -0,0 +1,155 @@
+package order
+
+import (
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+)
+
+// SEQUENCE_RESERVATION is how long handed out sequences are reserved for transactions which are not submitted (yet).
+// After this period without any activity for the account, the sequence of the chain is used again.
+const SEQUENCE_RESERVATION = 1 * time.Minute
+
+// sequenceTracker hands out increasing account sequences for transactions which are created but not yet included in a
+// block, so that several transactions can be created for an account within a single block.
+// The cache value is the next sequence to hand out (uint64).
+type sequenceTracker struct {
+	mutex *sync.RWMutex
+	data  map[string]*dmncache.LockableCache
+}
+
+func newSequenceTracker() *sequenceTracker {
+	t := &sequenceTracker{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}
+	go dmncache.CleanCache(t.data, t.mutex, SEQUENCE_RESERVATION)
+	return t
+}
+
+// sequenceKey is the network and the account address bytes (the bech32 prefix differs per network)
+func sequenceKey(network metadata.Network, address string) (string, error) {
+	_, addr, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", err
+	}
+	return network.String() + "|" + string(addr), nil
+}
+
+// next returns the sequence to use for a new transaction and reserves it.
+// The chain sequence is used if it is ahead of the reservations (transactions signed elsewhere) or when the
+// reservations have expired (transactions which were never submitted).
+func (t *sequenceTracker) next(key string, chainSequence uint64, now time.Time) uint64 {
+	t.mutex.Lock()
+	sequence := chainSequence
+	if c, ok := t.data[key]; ok && now.Sub(c.LastUpdated) < SEQUENCE_RESERVATION {
+		if reserved := c.Value.(uint64); reserved > sequence {
+			sequence = reserved
+		}
+	}
+	t.data[key] = &dmncache.LockableCache{LastUpdated: now, Value: sequence + 1}
+	t.mutex.Unlock()
+	return sequence
+}
+
+// confirm registers a transaction with the sequence as accepted by the chain
+func (t *sequenceTracker) confirm(key string, sequence uint64, now time.Time) {
+	t.mutex.Lock()
+	if c, ok := t.data[key]; !ok || c.Value.(uint64) <= sequence {
+		t.data[key] = &dmncache.LockableCache{LastUpdated: now, Value: sequence + 1}
+	} else {
+		c.LastUpdated = now
+	}
+	t.mutex.Unlock()
+}
+
+// resync drops the reservations after a sequence mismatch. If the expected sequence is known (reported by the chain),
+// it is used as the next sequence, otherwise the next sequence is taken from the chain.
+func (t *sequenceTracker) resync(key string, expected *uint64, now time.Time) {
+	t.mutex.Lock()
+	if expected != nil {
+		t.data[key] = &dmncache.LockableCache{LastUpdated: now, Value: *expected}
+	} else {
+		delete(t.data, key)
+	}
+	t.mutex.Unlock()
+}
+
+// release returns the sequence of a transaction which is rejected without being included in a block, so that it is
+// handed out again. Transactions created with a later sequence will fail on the gap anyway.
+func (t *sequenceTracker) release(key string, sequence uint64, now time.Time) {
+	t.mutex.Lock()
+	if c, ok := t.data[key]; ok && c.Value.(uint64) > sequence {
+		t.data[key] = &dmncache.LockableCache{LastUpdated: now, Value: sequence}
+	}
+	t.mutex.Unlock()
+}
+
+// expectedSequenceRegex matches the expected sequence in the log of an incorrect account sequence error
+var expectedSequenceRegex = regexp.MustCompile(`account sequence mismatch, expected (\d+)`)
+
+func expectedSequence(log string) *uint64 {
+	m := expectedSequenceRegex.FindStringSubmatch(log)
+	if m == nil {
+		return nil
+	}
+	sequence, err := strconv.ParseUint(m[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &sequence
+}
+
+// pipelined returns true if the transaction is only rejected for being signed with a sequence ahead of the sequence of
+// the chain: A sequence reserved while the transactions with the preceding sequences are not committed yet.
+func pipelined(sigs []signing.SignatureV2, submitErr error) bool {
+	chainErr, ok := submitErr.(*ChainError)
+	if !ok || chainErr.Name != "sequence.mismatch" {
+		return false
+	}
+	expected := expectedSequence(chainErr.Message)
+	if expected == nil {
+		return false
+	}
+	for _, sig := range sigs {
+		if sig.Sequence > *expected {
+			return true
+		}
+	}
+	return false
+}
+
+// learnSequences updates the tracked sequences of the signers of a submitted transaction with the result of the chain.
+// simulated indicates that the error is from the simulation, so that the sequence has not been used.
+// A sequence mismatch only resyncs the sequences when the chain expects a later sequence than the signed one: A signed
+// sequence ahead of the chain belongs to a transaction after others which are not committed yet, whose reservations
+// remain valid.
+func (a *Application) learnSequences(network metadata.Network, sigs []signing.SignatureV2, submitErr error, simulated bool) {
+	now := time.Now()
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			continue
+		}
+		key := network.String() + "|" + string(sig.PubKey.Address())
+		if submitErr == nil {
+			a.sequences.confirm(key, sig.Sequence, now)
+			continue
+		}
+		chainErr, ok := submitErr.(*ChainError)
+		switch {
+		case ok && chainErr.Name == "sequence.mismatch":
+			if expected := expectedSequence(chainErr.Message); expected != nil && *expected > sig.Sequence {
+				a.sequences.resync(key, expected, now)
+			}
+		case ok && simulated:
+			a.sequences.release(key, sig.Sequence, now)
+		}
+	}
+}
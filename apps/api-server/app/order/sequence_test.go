@@ -0,0 +1,103 @@
+package order
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+)
+
+func Test_SequenceTracker(t *testing.T) {
+	tracker := &sequenceTracker{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}
+	now := time.Now()
+	key := "MAINNET|account"
+	// Several transactions within a single block get increasing sequences:
+	for i, want := range []uint64{5, 6, 7} {
+		if got := tracker.next(key, 5, now); got != want {
+			t.Errorf("next %d: got %d, expected %d", i, got, want)
+		}
+	}
+	// The chain sequence is used when it is ahead (transactions signed elsewhere):
+	if got := tracker.next(key, 10, now); got != 10 {
+		t.Errorf("chain ahead: got %d, expected 10", got)
+	}
+	// A transaction rejected in the simulation releases its sequence:
+	tracker.release(key, 10, now)
+	if got := tracker.next(key, 8, now); got != 10 {
+		t.Errorf("release: got %d, expected 10", got)
+	}
+	// A sequence mismatch resyncs to the expected sequence:
+	expected := expectedSequence("account sequence mismatch, expected 9, got 11: incorrect account sequence")
+	if expected == nil || *expected != 9 {
+		t.Fatalf("expected sequence: got %v, expected 9", expected)
+	}
+	tracker.resync(key, expected, now)
+	if got := tracker.next(key, 8, now); got != 9 {
+		t.Errorf("resync: got %d, expected 9", got)
+	}
+	// Confirmed transactions move the next sequence forward:
+	tracker.confirm(key, 12, now)
+	if got := tracker.next(key, 8, now); got != 13 {
+		t.Errorf("confirm: got %d, expected 13", got)
+	}
+	// Reservations expire, after which the chain sequence is used again:
+	if got := tracker.next(key, 8, now.Add(SEQUENCE_RESERVATION)); got != 8 {
+		t.Errorf("expired: got %d, expected 8", got)
+	}
+	if expectedSequence("insufficient funds") != nil {
+		t.Errorf("expected sequence in unrelated log")
+	}
+}
+
+func Test_PipelinedSequences(t *testing.T) {
+	a := &Application{sequences: &sequenceTracker{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}}
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	key := metadata.Network_MAINNET.String() + "|" + string(pubKey.Address())
+	signed := func(sequence uint64) []signing.SignatureV2 {
+		return []signing.SignatureV2{{PubKey: pubKey, Sequence: sequence}}
+	}
+	mismatch := func(expected, got string) error {
+		return &ChainError{Name: "sequence.mismatch", Message: "account sequence mismatch, expected " + expected + ", got " + got + ": incorrect account sequence"}
+	}
+	now := time.Now()
+	// Two orders are signed with reserved sequences before the first is committed (chain sequence 5):
+	first, second := a.sequences.next(key, 5, now), a.sequences.next(key, 5, now)
+	if first != 5 || second != 6 {
+		t.Fatalf("reserved %d and %d, expected 5 and 6", first, second)
+	}
+	// The first is submitted and accepted:
+	a.learnSequences(metadata.Network_MAINNET, signed(first), nil, false)
+	// The simulation of the second (against the committed sequence) rejects it for being ahead of the chain, which is
+	// tolerated and keeps the reservations:
+	simulateErr := mismatch("5", "6")
+	if !pipelined(signed(second), simulateErr) {
+		t.Errorf("sequence ahead of the chain not tolerated")
+	}
+	a.learnSequences(metadata.Network_MAINNET, signed(second), simulateErr, true)
+	if got := a.sequences.next(key, 5, now); got != 7 {
+		t.Errorf("next after the pipelined order: got %d, expected 7", got)
+	}
+	// A sequence behind the chain (transactions signed elsewhere) is rejected and resyncs:
+	staleErr := mismatch("9", "7")
+	if pipelined(signed(7), staleErr) {
+		t.Errorf("sequence behind the chain tolerated")
+	}
+	a.learnSequences(metadata.Network_MAINNET, signed(7), staleErr, true)
+	if got := a.sequences.next(key, 5, now); got != 9 {
+		t.Errorf("next after the resync: got %d, expected 9", got)
+	}
+	if pipelined(signed(10), &ChainError{Name: "insufficient.funds", Message: "insufficient funds"}) {
+		t.Errorf("unrelated error tolerated")
+	}
+}
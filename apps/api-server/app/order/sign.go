@@ -0,0 +1,164 @@
+package order
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/coreum/v5/pkg/client"
+)
+
+// SignDocs contains everything an external signer (hardware wallet, Keplr style wallet) needs to sign the transaction
+// without any additional chain queries.
+type SignDocs struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	Gas           uint64    // Simulated gas used, multiplied by the gas adjustment
+	Fee           sdk.Coins // Gas multiplied by the adjusted minimum gas price of the chain
+	// SIGN_MODE_DIRECT sign doc. Only present if the public key of the sender is known, either from the chain (the
+	// account has signed a transaction before) or from the request.
+	SignDoc *txtypes.SignDoc `json:",omitempty"`
+	// SIGN_MODE_LEGACY_AMINO_JSON sign doc (the exact bytes to sign)
+	AminoSignDoc json.RawMessage
+}
+
+// SignDocs builds an unsigned transaction with the given msgs for the sender and returns the sign docs for it.
+// sequence is the account sequence as handed out by AccountSequence.
+// The gas is estimated by simulating the transaction against the node (with the committed account sequence).
+// pubKey is optional (base64 encoded compressed secp256k1 key) and only used when the account has no public key on
+// chain yet.
+func (a *Application) SignDocs(ctx context.Context, network metadata.Network, sender, pubKey string, sequence uint64, msgs ...sdk.Msg) (*SignDocs, error) {
+	txClient, ok := a.TxEncoder[network]
+	if !ok {
+		return nil, fmt.Errorf("no node connection for network %s", network)
+	}
+	// The address is decoded without the global bech32 prefix, since the prefix differs per network
+	_, addr, err := bech32.DecodeAndConvert(sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %s: %w", sender, err)
+	}
+	from := sdk.AccAddress(addr)
+	acc, err := a.account(network, sender)
+	if err != nil {
+		return nil, err
+	}
+	pk := acc.GetPubKey()
+	if pk == nil && pubKey != "" {
+		if pk, err = decodePubKey(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	chainID, err := a.chainID(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx := txClient.clientContext.WithChainID(chainID).WithFromAddress(from).WithUnsignedSimulation(true)
+	txf := txClient.txFactory.
+		WithChainID(chainID).
+		WithAccountNumber(acc.GetAccountNumber()).
+		WithSequence(sequence)
+
+	gasPrice, err := client.GetGasPrice(ctx, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice.Amount = gasPrice.Amount.Mul(clientCtx.GasPriceAdjustment())
+	// The simulation runs against the committed state, in which the ante handler rejects any sequence but the committed
+	// one: The gas is estimated with the sequence of the chain, the sequence handed out (which can be ahead of the chain
+	// for pipelined orders) is only used in the sign docs.
+	_, gas, err := client.CalculateGas(ctx, clientCtx, txf.WithSequence(acc.GetSequence()), msgs...)
+	if err != nil {
+		return nil, err
+	}
+	unsignedTx, err := txf.WithGas(gas).WithGasPrices(gasPrice.String()).BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       sender,
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      sequence,
+		PubKey:        pk,
+	}
+	handler := clientCtx.TxConfig().SignModeHandler()
+	res := &SignDocs{
+		ChainID:       chainID,
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      sequence,
+		Gas:           gas,
+		Fee:           unsignedTx.GetTx().GetFee(),
+	}
+	aminoBytes, err := authsigning.GetSignBytesAdapter(ctx, handler, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, unsignedTx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res.AminoSignDoc = aminoBytes
+	if pk == nil {
+		return res, nil
+	}
+
+	// The signer info (public key and sign mode) is part of the SIGN_MODE_DIRECT sign doc
+	if err := unsignedTx.SetSignatures(signing.SignatureV2{
+		PubKey:   pk,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}); err != nil {
+		return nil, err
+	}
+	directBytes, err := authsigning.GetSignBytesAdapter(ctx, handler, signing.SignMode_SIGN_MODE_DIRECT, signerData, unsignedTx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	signDoc := &txtypes.SignDoc{}
+	if err := signDoc.Unmarshal(directBytes); err != nil {
+		return nil, err
+	}
+	res.SignDoc = signDoc
+	return res, nil
+}
+
+// chainID returns the chain ID reported by the node of the network.
+// The client contexts all use the mainnet chain ID, which is not valid for signing on other networks.
+func (a *Application) chainID(ctx context.Context, network metadata.Network) (string, error) {
+	a.chainIDMutex.RLock()
+	chainID, ok := a.chainIDs[network]
+	a.chainIDMutex.RUnlock()
+	if ok {
+		return chainID, nil
+	}
+	res, err := cmtservice.NewServiceClient(a.TxEncoder[network].clientContext).GetNodeInfo(ctx, &cmtservice.GetNodeInfoRequest{})
+	if err != nil {
+		return "", err
+	}
+	chainID = res.GetDefaultNodeInfo().GetNetwork()
+	a.chainIDMutex.Lock()
+	a.chainIDs[network] = chainID
+	a.chainIDMutex.Unlock()
+	return chainID, nil
+}
+
+func decodePubKey(pubKey string) (cryptotypes.PubKey, error) {
+	key, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(key) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length %d", len(key))
+	}
+	return &secp256k1.PubKey{Key: key}, nil
+}
@@ -0,0 +1,203 @@
+package order
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	"github.com/CoreumFoundation/coreum/v5/pkg/client"
+	assetfttypes "github.com/CoreumFoundation/coreum/v5/x/asset/ft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+// ChainError is a transaction rejected by the chain (in simulation or broadcast), mapped to a stable error name.
+// Message is the log of the chain.
+type ChainError struct {
+	Name    string
+	Message string
+}
+
+func (e *ChainError) Error() string {
+	return e.Name + ": " + e.Message
+}
+
+// chainErrors maps (parts of) the chain error logs to the error names. The first match is used, so the more specific
+// DEX errors (which all share the dex invalid input error) are before the generic errors.
+var chainErrors = []struct {
+	match string
+	name  string
+}{
+	{"multiple of price tick", "price.tick.invalid"},
+	{"multiple of quantity step", "quantity.step.invalid"},
+	{dextypes.ErrRecordNotFound.Error(), "order.not_found"},
+	{sdkerrors.ErrWrongSequence.Error(), "sequence.mismatch"},
+	{sdkerrors.ErrInsufficientFunds.Error(), "funds.insufficient"},
+	{assetfttypes.ErrDEXInsufficientSpendableBalance.Error(), "funds.insufficient"},
+	{sdkerrors.ErrInsufficientFee.Error(), "fee.insufficient"},
+	{sdkerrors.ErrOutOfGas.Error(), "gas.insufficient"},
+	{sdkerrors.ErrUnauthorized.Error(), "signature.invalid"},
+	{sdkerrors.ErrTxInMempoolCache.Error(), "tx.duplicate"},
+	{sdkerrors.ErrMempoolIsFull.Error(), "mempool.full"},
+	{dextypes.ErrInvalidInput.Error(), "order.invalid"},
+}
+
+// chainErrorName returns the error name for the log of a failed transaction
+func chainErrorName(log string) string {
+	for _, e := range chainErrors {
+		if strings.Contains(log, e.match) {
+			return e.name
+		}
+	}
+	return "tx.failed"
+}
+
+// toChainError converts the error of a simulation or broadcast into a ChainError.
+// Errors which are not a rejection of the transaction (node not reachable, timeouts) are returned as is.
+func toChainError(err error) error {
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+			return err
+		}
+	}
+	return &ChainError{
+		Name:    chainErrorName(err.Error()),
+		Message: err.Error(),
+	}
+}
+
+// OrderSequence is the ID of an order with the sequence assigned by the DEX
+type OrderSequence struct {
+	ID       string
+	Sequence uint64
+}
+
+// SubmitResult is the submitted transaction. Pending is set when the transaction is accepted by the node, but is not
+// included in a block within the timeout of the client: Only the TxHash of the TxResponse is set, and the status is to
+// be tracked with TxStatus.
+type SubmitResult struct {
+	TxResponse   *sdk.TxResponse
+	PlacedOrders []OrderSequence
+	Pending      bool
+}
+
+// SubmitTx simulates the signed transaction against the node and broadcasts it if the simulation succeeds (or only
+// fails on a sequence ahead of the chain), and waits for the inclusion in a block. Rejections of the transaction are
+// returned as ChainError.
+func (a *Application) SubmitTx(ctx context.Context, network metadata.Network, rawTx []byte) (*SubmitResult, error) {
+	clientCtx := a.TxEncoder[network].clientContext
+	sigTx, err := decodeSignedTx(clientCtx, rawTx)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, &ChainError{Name: "tx.invalid", Message: err.Error()}
+	}
+	if _, err := sdktx.NewServiceClient(clientCtx).Simulate(ctx, &sdktx.SimulateRequest{TxBytes: rawTx}); err != nil {
+		err = toChainError(err)
+		// The simulation runs against the committed state: A transaction signed with a sequence reserved ahead of the
+		// chain (several orders within a block) is rejected on its sequence, which the broadcast checks against the
+		// pending transactions instead
+		if !pipelined(sigs, err) {
+			a.learnSequences(network, sigs, err, true)
+			return nil, err
+		}
+	}
+	// The inclusion is awaited separately, so that a transaction which is not included in time is not reported as
+	// rejected: It can still be included, and a retry by the client would place the order twice
+	txResponse, err := client.BroadcastRawTx(ctx, clientCtx.WithAwaitTx(false), rawTx)
+	if err != nil {
+		err = toChainError(err)
+		a.learnSequences(network, sigs, err, false)
+		return nil, err
+	}
+	a.learnSequences(network, sigs, nil, false)
+	a.trackSubmitted(network, txResponse.TxHash, time.Now())
+	if !clientCtx.GetAwaitTx() {
+		return &SubmitResult{TxResponse: txResponse, PlacedOrders: placedOrders(txResponse)}, nil
+	}
+	includedResponse, err := client.AwaitTx(ctx, clientCtx, txResponse.TxHash)
+	if err != nil {
+		return a.awaitFailed(ctx, clientCtx, txResponse, err)
+	}
+	txResponse = includedResponse
+	return &SubmitResult{
+		TxResponse:   txResponse,
+		PlacedOrders: placedOrders(txResponse),
+	}, nil
+}
+
+// awaitFailed resolves a broadcast transaction which could not be awaited: A transaction which is included in a block
+// but failed is returned as ChainError, otherwise (not included within the timeout, node not reachable) the
+// transaction is returned as pending.
+func (a *Application) awaitFailed(ctx context.Context, clientCtx client.Context, txResponse *sdk.TxResponse, awaitErr error) (*SubmitResult, error) {
+	res, err := sdktx.NewServiceClient(clientCtx).GetTx(ctx, &sdktx.GetTxRequest{Hash: txResponse.TxHash})
+	if err == nil && res.GetTxResponse().Code != 0 {
+		return nil, &ChainError{
+			Name:    chainErrorName(res.GetTxResponse().RawLog),
+			Message: awaitErr.Error(),
+		}
+	}
+	logger.Warnf("Tx %s is not included in a block yet: %v", txResponse.TxHash, awaitErr)
+	return &SubmitResult{
+		TxResponse:   &sdk.TxResponse{TxHash: txResponse.TxHash},
+		PlacedOrders: make([]OrderSequence, 0),
+		Pending:      true,
+	}, nil
+}
+
+// TxSigners returns the signers of the signed transaction as hex encoded address bytes (the bech32 prefix differs per
+// network)
+func (a *Application) TxSigners(network metadata.Network, rawTx []byte) ([]string, error) {
+	sigTx, err := decodeSignedTx(a.TxEncoder[network].clientContext, rawTx)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, &ChainError{Name: "tx.invalid", Message: err.Error()}
+	}
+	res := make([]string, 0, len(signers))
+	for _, signer := range signers {
+		res = append(res, hex.EncodeToString(signer))
+	}
+	return res, nil
+}
+
+func decodeSignedTx(clientCtx client.Context, rawTx []byte) (authsigning.SigVerifiableTx, error) {
+	decodedTx, err := clientCtx.TxConfig().TxDecoder()(rawTx)
+	if err != nil {
+		return nil, &ChainError{Name: "tx.invalid", Message: err.Error()}
+	}
+	sigTx, ok := decodedTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, &ChainError{Name: "tx.invalid", Message: "transaction is not signed"}
+	}
+	return sigTx, nil
+}
+
+// placedOrders returns the orders placed by the transaction from the EventOrderPlaced events
+func placedOrders(txResponse *sdk.TxResponse) []OrderSequence {
+	placed := make([]OrderSequence, 0)
+	for _, event := range txResponse.Events {
+		if event.Type != proto.MessageName(&dextypes.EventOrderPlaced{}) {
+			continue
+		}
+		if _, o, ok := orderEvent(txResponse.TxHash, event); ok {
+			placed = append(placed, o)
+		}
+	}
+	return placed
+}
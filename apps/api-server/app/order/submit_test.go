@@ -0,0 +1,52 @@
+package order
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+)
+
+func Test_ChainErrorName(t *testing.T) {
+	tests := []struct {
+		name string
+		log  string
+		want string
+	}{
+		{"price tick", "rpc error: code = Unknown desc = invalid price, has to be multiple of price tick: 10^-6: invalid input", "price.tick.invalid"},
+		{"quantity step", "rpc error: code = Unknown desc = invalid quantity, has to be multiple of quantity step: 10^3: invalid input", "quantity.step.invalid"},
+		{"order not found", "rpc error: code = Unknown desc = failed to get order id: record not found", "order.not_found"},
+		{"sequence", "account sequence mismatch, expected 12, got 11: incorrect account sequence", "sequence.mismatch"},
+		{"bank funds", "spendable balance 10udevcore is smaller than 20udevcore: insufficient funds", "funds.insufficient"},
+		{"dex funds", "1000ucore is not available, available 10ucore: DEX insufficient spendable balance", "funds.insufficient"},
+		{"fee", "insufficient fees; got: 1udevcore required: 100udevcore: insufficient fee", "fee.insufficient"},
+		{"signature", "signature verification failed; please verify account number (1) and chain-id (coreum-devnet-1): unauthorized", "signature.invalid"},
+		{"dex other", "base denom abc does not exist: invalid input", "order.invalid"},
+		{"unknown", "something else went wrong", "tx.failed"},
+	}
+	for _, test := range tests {
+		if got := chainErrorName(test.log); got != test.want {
+			t.Errorf("%s: got %s, expected %s", test.name, got, test.want)
+		}
+	}
+}
+
+func Test_SubmittedRecently(t *testing.T) {
+	a := &Application{submitted: &cache{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}}
+	now := time.Now()
+	a.trackSubmitted(metadata.Network_MAINNET, "5f2a0e3c", now)
+	if !a.submittedRecently(metadata.Network_MAINNET, "5F2A0E3C", now.Add(time.Minute)) {
+		t.Errorf("submitted tx not pending")
+	}
+	if a.submittedRecently(metadata.Network_TESTNET, "5F2A0E3C", now.Add(time.Minute)) {
+		t.Errorf("tx of another network pending")
+	}
+	if a.submittedRecently(metadata.Network_MAINNET, "5F2A0E3C", now.Add(TX_PENDING_WINDOW)) {
+		t.Errorf("tx pending after the pending window")
+	}
+}
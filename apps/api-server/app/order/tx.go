@@ -0,0 +1,199 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+type TxStatus string
+
+const (
+	TxStatusPending  TxStatus = "pending"  // Not (yet) included in a block
+	TxStatusIncluded TxStatus = "included" // Included in a block and executed successfully
+	TxStatusFailed   TxStatus = "failed"   // Included in a block, but the execution failed
+	TxStatusUnknown  TxStatus = "unknown"  // Neither in a block nor pending (never broadcast, evicted from the mempool or a mistyped hash)
+)
+
+// TX_PENDING_WINDOW is how long a transaction submitted through this server is reported as pending while it is not
+// included in a block. The mempool of the node only lists a limited number of transactions, so a transaction submitted
+// here is not looked up in the mempool within this window.
+const TX_PENDING_WINDOW = 10 * time.Minute
+
+// MEMPOOL_LOOKUP_LIMIT is the number of pending transactions of the node checked for an unknown transaction (the
+// maximum page size of the unconfirmed_txs endpoint)
+const MEMPOOL_LOOKUP_LIMIT = 100
+
+// mempoolClient is the part of the RPC client of the node that lists the pending transactions
+type mempoolClient interface {
+	UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error)
+}
+
+// TxOrder is an order of the signer of the transaction which has been placed or closed by the transaction.
+// Order is the order as written by the data-aggregator, it is nil until the aggregator has processed the block.
+type TxOrder struct {
+	ID       string
+	Sequence uint64
+	Order    *dmn.Order `json:",omitempty"`
+}
+
+type Tx struct {
+	TXHash    string
+	Status    TxStatus
+	Height    int64  `json:",omitempty"`
+	Code      uint32 `json:",omitempty"` // Error code of the failed transaction
+	Codespace string `json:",omitempty"`
+	Log       string `json:",omitempty"` // Error log of the failed transaction
+	Error     string `json:",omitempty"` // Error name of the failed transaction, as for a rejected submit (ChainError)
+	GasWanted int64  `json:",omitempty"`
+	GasUsed   int64  `json:",omitempty"`
+	Orders    []TxOrder
+}
+
+// TxStatus returns the status of a transaction on chain with the orders it placed or closed.
+// A transaction which is not included in a block is reported as pending if it was submitted through this server within
+// the TX_PENDING_WINDOW or is in the mempool of the node, and as unknown otherwise.
+func (a *Application) TxStatus(ctx context.Context, network metadata.Network, hash string) (*Tx, error) {
+	hash = strings.ToUpper(strings.TrimPrefix(hash, "0x"))
+	tx := &Tx{
+		TXHash: hash,
+		Status: TxStatusPending,
+		Orders: make([]TxOrder, 0),
+	}
+	res, err := sdktx.NewServiceClient(a.TxEncoder[network].clientContext).GetTx(ctx, &sdktx.GetTxRequest{Hash: hash})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		pending, err := a.txPending(ctx, network, hash)
+		if err != nil {
+			return nil, err
+		}
+		if !pending {
+			tx.Status = TxStatusUnknown
+		}
+		return tx, nil
+	}
+	txResponse := res.GetTxResponse()
+	tx.Height = txResponse.Height
+	tx.GasWanted = txResponse.GasWanted
+	tx.GasUsed = txResponse.GasUsed
+	if txResponse.Code != 0 {
+		tx.Status = TxStatusFailed
+		tx.Code = txResponse.Code
+		tx.Codespace = txResponse.Codespace
+		tx.Log = txResponse.RawLog
+		tx.Error = chainErrorName(txResponse.RawLog)
+		return tx, nil
+	}
+	tx.Status = TxStatusIncluded
+	for _, o := range txOrders(txResponse) {
+		txOrder := TxOrder{ID: o.ID, Sequence: o.Sequence}
+		// Not found means that the data-aggregator has not processed the block yet
+		if order, err := a.GetOrder(ctx, network, int64(o.Sequence)); err == nil {
+			txOrder.Order = order
+		}
+		tx.Orders = append(tx.Orders, txOrder)
+	}
+	return tx, nil
+}
+
+// txPending returns true if the transaction which is not included in a block is still expected to be included
+func (a *Application) txPending(ctx context.Context, network metadata.Network, hash string) (bool, error) {
+	if a.submittedRecently(network, hash, time.Now()) {
+		return true, nil
+	}
+	mempool, ok := a.TxEncoder[network].clientContext.RPCClient().(mempoolClient)
+	if !ok {
+		// No RPC client to check the mempool with: Keep reporting the transaction as pending
+		return true, nil
+	}
+	limit := MEMPOOL_LOOKUP_LIMIT
+	res, err := mempool.UnconfirmedTxs(ctx, &limit)
+	if err != nil {
+		return false, err
+	}
+	for _, pendingTx := range res.Txs {
+		if fmt.Sprintf("%X", pendingTx.Hash()) == hash {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// trackSubmitted registers a transaction which is accepted by the node, so that it is reported as pending until it is
+// included in a block (or the TX_PENDING_WINDOW passes)
+func (a *Application) trackSubmitted(network metadata.Network, hash string, now time.Time) {
+	a.submitted.mutex.Lock()
+	a.submitted.data[network.String()+"|"+strings.ToUpper(hash)] = &dmncache.LockableCache{LastUpdated: now}
+	a.submitted.mutex.Unlock()
+}
+
+func (a *Application) submittedRecently(network metadata.Network, hash string, now time.Time) bool {
+	a.submitted.mutex.RLock()
+	defer a.submitted.mutex.RUnlock()
+	c, ok := a.submitted.data[network.String()+"|"+hash]
+	return ok && now.Sub(c.LastUpdated) < TX_PENDING_WINDOW
+}
+
+// txOrders returns the orders of the signers of the transaction which are placed or closed by the transaction.
+// Orders of other accounts which are matched (and possibly closed) by the transaction are not included.
+func txOrders(txResponse *sdk.TxResponse) []OrderSequence {
+	signers := make(map[string]bool)
+	for _, event := range txResponse.Events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			// acc_seq is {address}/{sequence}
+			if attribute.Key == sdk.AttributeKeyAccountSequence {
+				signers[strings.SplitN(attribute.Value, "/", 2)[0]] = true
+			}
+		}
+	}
+	orders := make([]OrderSequence, 0)
+	known := make(map[uint64]bool)
+	for _, event := range txResponse.Events {
+		creator, o, ok := orderEvent(txResponse.TxHash, event)
+		if !ok || !signers[creator] || known[o.Sequence] {
+			continue
+		}
+		known[o.Sequence] = true
+		orders = append(orders, o)
+	}
+	return orders
+}
+
+// orderEvent parses the EventOrderPlaced and EventOrderClosed events into the creator and the order
+func orderEvent(txHash string, event abci.Event) (string, OrderSequence, bool) {
+	if event.Type != proto.MessageName(&dextypes.EventOrderPlaced{}) && event.Type != proto.MessageName(&dextypes.EventOrderClosed{}) {
+		return "", OrderSequence{}, false
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		logger.Errorf("Error parsing %s in tx %s: %v", event.Type, txHash, err)
+		return "", OrderSequence{}, false
+	}
+	switch e := msg.(type) {
+	case *dextypes.EventOrderPlaced:
+		return e.Creator, OrderSequence{ID: e.ID, Sequence: e.Sequence}, true
+	case *dextypes.EventOrderClosed:
+		return e.Creator, OrderSequence{ID: e.ID, Sequence: e.Sequence}, true
+	}
+	return "", OrderSequence{}, false
+}
@@ -0,0 +1,202 @@
+package order
+
+import (
+	"context"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+type WalletAsset struct {
+	Denom        string
+	Amount       string
+	SymbolAmount string
+	// Amount locked in open DEX orders (including the order reserve)
+	LockedAmount       string
+	LockedSymbolAmount string
+	// Order reserve of the open DEX orders, held by the DEX module until the orders are closed (part of the locked amount)
+	ReserveAmount       string
+	ReserveSymbolAmount string
+	// Amount which can be used for new orders and transfers (the amount minus the locked (DEX and vesting) and frozen
+	// amounts)
+	AvailableAmount       string
+	AvailableSymbolAmount string
+	USDValue              float64 // USD value of the amount, 0 if there is no USD rate for the denom
+}
+
+type Wallet struct {
+	Assets   []WalletAsset
+	USDValue float64 // Total USD value of the assets
+}
+
+// Wallet returns the assets of the address with the total USD value
+func (a *Application) Wallet(network metadata.Network, address string) (*Wallet, error) {
+	assets, err := a.WalletAssets(network, address)
+	if err != nil {
+		return nil, err
+	}
+	wallet := &Wallet{Assets: assets}
+	for _, asset := range assets {
+		wallet.USDValue += asset.USDValue
+	}
+	return wallet, nil
+}
+
+func (a *Application) WalletAssets(network metadata.Network, address string) ([]WalletAsset, error) {
+	ctx := context.Background()
+	coins := sdk.Coins{}
+	bankClient := banktypes.NewQueryClient(a.TxEncoder[network].clientContext)
+	var paginationKey []byte = nil
+	for {
+		res, err := bankClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:      address,
+			Pagination:   &query.PageRequest{Key: paginationKey},
+			ResolveDenom: false,
+		})
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Add(res.Balances...)
+		paginationKey = res.Pagination.NextKey
+		if paginationKey == nil {
+			break
+		}
+	}
+	locked, err := a.lockedBalances(ctx, network, address, coins)
+	if err != nil {
+		return nil, err
+	}
+	// Transform the coins to WalletAsset and add the symbol amount (apply precision)
+	walletAssets := make([]WalletAsset, 0)
+	for _, coin := range coins {
+		denomCurrency, err := a.currencyClient.GetCurrency(ctx, network, coin.Denom)
+		if err != nil {
+			return walletAssets, err
+		}
+		precision := int32(0)
+		if denomCurrency.Denom != nil && denomCurrency.Denom.Precision != nil {
+			precision = *denomCurrency.Denom.Precision
+		}
+		lockedInDEX := locked.dex.AmountOf(coin.Denom)
+		reserve := locked.reserve.AmountOf(coin.Denom)
+		available := locked.available(coin)
+		walletAssets = append(walletAssets, WalletAsset{
+			Denom:                 coin.Denom,
+			Amount:                coin.Amount.String(),
+			SymbolAmount:          symbolAmount(coin.Amount, precision),
+			LockedAmount:          lockedInDEX.String(),
+			LockedSymbolAmount:    symbolAmount(lockedInDEX, precision),
+			ReserveAmount:         reserve.String(),
+			ReserveSymbolAmount:   symbolAmount(reserve, precision),
+			AvailableAmount:       available.String(),
+			AvailableSymbolAmount: symbolAmount(available, precision),
+			USDValue:              a.usdValue(ctx, network, coin),
+		})
+	}
+	return walletAssets, nil
+}
+
+// lockedBalances are the parts of the balances of an account which can not be used for new orders and transfers
+type lockedBalances struct {
+	dex     sdk.Coins // Locked in the open DEX orders, including the order reserves
+	reserve sdk.Coins // Order reserves of the open DEX orders (part of dex)
+	vesting sdk.Coins
+	frozen  sdk.Coins
+}
+
+// lockedBalances queries the locked balances of the account for all denoms at once: The DEX locks are derived from the
+// open orders, the frozen balances and the vesting schedule are queried for the account.
+func (a *Application) lockedBalances(ctx context.Context, network metadata.Network, address string, coins sdk.Coins) (*lockedBalances, error) {
+	locked := &lockedBalances{vesting: sdk.Coins{}}
+	if coins.Empty() {
+		return locked, nil
+	}
+	reader := a.TxEncoder[network].reader
+	orders, err := reader.QueryOrdersByCreator(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	locked.dex, locked.reserve = dexLocked(orders)
+	if locked.frozen, err = reader.QueryFrozenBalances(ctx, address); err != nil {
+		return nil, err
+	}
+	acc, err := a.account(network, address)
+	if err != nil {
+		return nil, err
+	}
+	if vestingAcc, ok := acc.(vestingexported.VestingAccount); ok {
+		locked.vesting = vestingAcc.LockedCoins(time.Now())
+	}
+	return locked, nil
+}
+
+// dexLocked returns the balances locked by the open orders: The remaining spendable balance of every order (in the
+// base denom for sells, the quote denom for buys) and its order reserve, which is returned separately as well.
+func dexLocked(orders []dextypes.Order) (locked, reserves sdk.Coins) {
+	locked, reserves = sdk.Coins{}, sdk.Coins{}
+	for _, order := range orders {
+		spendDenom := order.QuoteDenom
+		if order.Side == dextypes.SIDE_SELL {
+			spendDenom = order.BaseDenom
+		}
+		if order.RemainingSpendableBalance.IsPositive() {
+			locked = locked.Add(sdk.NewCoin(spendDenom, order.RemainingSpendableBalance))
+		}
+		if order.Reserve.IsValid() && order.Reserve.IsPositive() {
+			locked = locked.Add(order.Reserve)
+			reserves = reserves.Add(order.Reserve)
+		}
+	}
+	return locked, reserves
+}
+
+// available is the amount of the coin which can be used for new orders and transfers: The amount minus the locked
+// (DEX and vesting) and frozen amounts.
+func (l *lockedBalances) available(coin sdk.Coin) sdkmath.Int {
+	available := coin.Amount.
+		Sub(l.dex.AmountOf(coin.Denom)).
+		Sub(l.vesting.AmountOf(coin.Denom)).
+		Sub(l.frozen.AmountOf(coin.Denom))
+	if available.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return available
+}
+
+func symbolAmount(amount sdkmath.Int, precision int32) string {
+	return dec.NewFromBigInt(amount.BigInt(), 0).Div(dec.New(1, precision)).String()
+}
+
+// usdValue converts the coin to USD with the same rates as used for the tickers.
+// The rates are in USDC subunits per subunit, so the USDC precision is applied to the result.
+func (a *Application) usdValue(ctx context.Context, network metadata.Network, coin sdk.Coin) float64 {
+	if a.ticker == nil {
+		return 0.0
+	}
+	rate, usdc, err := a.ticker.USDRate(ctx, network, coin.Denom)
+	if err != nil {
+		logger.Errorf("Error getting USD rate for %s: %v", coin.Denom, err)
+		return 0.0
+	}
+	if rate == 0.0 {
+		return 0.0
+	}
+	usdcCurrency, err := a.currencyClient.GetCurrency(ctx, network, usdc.ToString())
+	if err != nil || usdcCurrency.Denom == nil || usdcCurrency.Denom.Precision == nil {
+		logger.Errorf("Error getting precision of %s: %v", usdc.ToString(), err)
+		return 0.0
+	}
+	return dec.NewFromBigInt(coin.Amount.BigInt(), 0).
+		Mul(dec.NewFromFloat(rate)).
+		Div(dec.New(1, *usdcCurrency.Denom.Precision)).
+		InexactFloat64()
+}
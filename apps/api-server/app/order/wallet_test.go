@@ -0,0 +1,50 @@
+package order
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+func Test_LockedBalances(t *testing.T) {
+	reserve := sdk.NewInt64Coin("ucore", 10)
+	orders := []dextypes.Order{
+		// A sell locks the base denom, a buy the quote denom, both lock the order reserve
+		{Side: dextypes.SIDE_SELL, BaseDenom: "ucore", QuoteDenom: "uusdc", RemainingSpendableBalance: sdkmath.NewInt(100), Reserve: reserve},
+		{Side: dextypes.SIDE_BUY, BaseDenom: "ucore", QuoteDenom: "uusdc", RemainingSpendableBalance: sdkmath.NewInt(50), Reserve: reserve},
+		// An order without a reserve (placed before the reserve was required)
+		{Side: dextypes.SIDE_BUY, BaseDenom: "uatom", QuoteDenom: "uusdc", RemainingSpendableBalance: sdkmath.NewInt(5)},
+	}
+	dex, reserves := dexLocked(orders)
+	if want := sdk.NewCoins(sdk.NewInt64Coin("ucore", 120), sdk.NewInt64Coin("uusdc", 55)); !dex.Equal(want) {
+		t.Errorf("locked in DEX: got %s, expected %s", dex, want)
+	}
+	if want := sdk.NewCoins(sdk.NewInt64Coin("ucore", 20)); !reserves.Equal(want) {
+		t.Errorf("reserves: got %s, expected %s", reserves, want)
+	}
+
+	locked := &lockedBalances{
+		dex:     dex,
+		reserve: reserves,
+		vesting: sdk.NewCoins(sdk.NewInt64Coin("ucore", 30)),
+		frozen:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 40), sdk.NewInt64Coin("uatom", 1000)),
+	}
+	tests := []struct {
+		name string
+		coin sdk.Coin
+		want int64
+	}{
+		{"dex and vesting", sdk.NewInt64Coin("ucore", 1000), 850},
+		{"dex and frozen", sdk.NewInt64Coin("uusdc", 100), 5},
+		{"nothing locked", sdk.NewInt64Coin("ubtc", 7), 7},
+		{"locked exceeds the amount", sdk.NewInt64Coin("uatom", 100), 0},
+	}
+	for _, test := range tests {
+		if got := locked.available(test.coin); !got.Equal(sdkmath.NewInt(test.want)) {
+			t.Errorf("%s: got %s, expected %d", test.name, got, test.want)
+		}
+	}
+}
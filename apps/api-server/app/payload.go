@@ -0,0 +1,299 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// message is a message of the hub for its listeners. The binary message (typed payload) is built once, by the first
+// listener which negotiated protobuf, and shared with the other listeners of the message.
+type message struct {
+	*updateproto.Subscribe
+	once   sync.Once
+	binary []byte
+	err    error
+}
+
+func newMessage(m *updateproto.Subscribe) *message {
+	return &message{Subscribe: m}
+}
+
+// encoded returns the binary message. A content which can not be converted is replaced by an error frame for the
+// subscription: The client subscribes again for a new snapshot.
+func (m *message) encoded() ([]byte, error) {
+	m.once.Do(func() {
+		u, err := newUpdate(m.Subscribe)
+		if err != nil {
+			logger.Errorf("Error converting the message: %v", err)
+			u = &updateproto.Update{Action: updateproto.Action_ERROR, Error: err.Error()}
+			if m.Subscription != nil {
+				u.Subscription = requestOf(m.Subscription)
+			}
+		}
+		m.binary, m.err = proto.Marshal(u)
+	})
+	return m.binary, m.err
+}
+
+// newUpdate returns the binary message of the message: The JSON content of the subscription as typed payload
+func newUpdate(m *updateproto.Subscribe) (*updateproto.Update, error) {
+	u := &updateproto.Update{Action: m.Action, Error: m.Error}
+	if m.Subscription == nil {
+		return u, nil
+	}
+	u.Subscription = &updateproto.Subscription{
+		Method:   m.Subscription.Method,
+		ID:       m.Subscription.ID,
+		Network:  m.Subscription.Network,
+		Sequence: m.Subscription.Sequence,
+		Type:     m.Subscription.Type,
+	}
+	if m.Subscription.Content == "" {
+		return u, nil
+	}
+	payload, err := payloadOf(m.Subscription.Method, m.Subscription.Content)
+	if err != nil {
+		return nil, fmt.Errorf("content of %s %s: %w", m.Subscription.Method, m.Subscription.ID, err)
+	}
+	u.Payload = payload
+	return u, nil
+}
+
+// payloadOf converts the JSON content of the method (as produced by fetch) into the typed payload
+func payloadOf(method updateproto.Method, content string) (*updateproto.Payload, error) {
+	b := []byte(content)
+	switch method {
+	case updateproto.Method_TRADES_FOR_SYMBOL,
+		updateproto.Method_TRADES_FOR_ACCOUNT,
+		updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL:
+		var trades []*dmn.Trade
+		if err := json.Unmarshal(b, &trades); err != nil {
+			return nil, err
+		}
+		res := &updateproto.Trades{Trades: make([]*updateproto.Trade, 0, len(trades))}
+		for _, t := range trades {
+			res.Trades = append(res.Trades, &updateproto.Trade{
+				Trade:              t.Trade,
+				HumanReadablePrice: t.HumanReadablePrice,
+				SymbolAmount:       t.SymbolAmount,
+				Status:             t.Status,
+			})
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_Trades{Trades: res}}, nil
+	case updateproto.Method_OHLC:
+		ohlcs, err := ohlcPoints(b)
+		if err != nil {
+			return nil, err
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_OHLCs{OHLCs: ohlcs}}, nil
+	case updateproto.Method_TICKER:
+		tickers := &dmn.USDTicker{}
+		if err := json.Unmarshal(b, tickers); err != nil {
+			return nil, err
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_Tickers{Tickers: &updateproto.Tickers{
+			Tickers:    tickerPoints(tickers.Tickers),
+			USDTickers: tickerPoints(tickers.USDTickers),
+		}}}, nil
+	case updateproto.Method_ORDERBOOK,
+		updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT:
+		orderbook := &coreum.OrderBookOrders{}
+		if err := json.Unmarshal(b, orderbook); err != nil {
+			return nil, err
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_OrderBook{OrderBook: orderBookOf(orderbook)}}, nil
+	case updateproto.Method_ORDERBOOKS_FOR_ACCOUNT:
+		var orderbooks map[string]*coreum.OrderBookOrders
+		if err := json.Unmarshal(b, &orderbooks); err != nil {
+			return nil, err
+		}
+		res := &updateproto.OrderBooks{OrderBooks: make(map[string]*updateproto.OrderBook, len(orderbooks))}
+		for sym, orderbook := range orderbooks {
+			res.OrderBooks[sym] = orderBookOf(orderbook)
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_OrderBooks{OrderBooks: res}}, nil
+	case updateproto.Method_WALLET:
+		var assets []order.WalletAsset
+		if err := json.Unmarshal(b, &assets); err != nil {
+			return nil, err
+		}
+		res := &updateproto.Wallet{Assets: make([]*updateproto.WalletAsset, 0, len(assets))}
+		for _, a := range assets {
+			res.Assets = append(res.Assets, &updateproto.WalletAsset{
+				Denom:                 a.Denom,
+				Amount:                a.Amount,
+				SymbolAmount:          a.SymbolAmount,
+				LockedAmount:          a.LockedAmount,
+				LockedSymbolAmount:    a.LockedSymbolAmount,
+				ReserveAmount:         a.ReserveAmount,
+				ReserveSymbolAmount:   a.ReserveSymbolAmount,
+				AvailableAmount:       a.AvailableAmount,
+				AvailableSymbolAmount: a.AvailableSymbolAmount,
+				USDValue:              a.USDValue,
+			})
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_Wallet{Wallet: res}}, nil
+	case updateproto.Method_TX_STATUS:
+		tx := &order.Tx{}
+		if err := json.Unmarshal(b, tx); err != nil {
+			return nil, err
+		}
+		res := &updateproto.Tx{
+			TXHash:    tx.TXHash,
+			Status:    string(tx.Status),
+			Height:    tx.Height,
+			Code:      tx.Code,
+			Codespace: tx.Codespace,
+			Log:       tx.Log,
+			Error:     tx.Error,
+			GasWanted: tx.GasWanted,
+			GasUsed:   tx.GasUsed,
+			Orders:    make([]*updateproto.TxOrder, 0, len(tx.Orders)),
+		}
+		for _, o := range tx.Orders {
+			txOrder := &updateproto.TxOrder{ID: o.ID, Sequence: o.Sequence}
+			if o.Order != nil {
+				txOrder.Order = &updateproto.Order{
+					Order:                 o.Order.Order,
+					HumanReadablePrice:    o.Order.HumanReadablePrice,
+					SymbolAmount:          o.Order.SymbolAmount,
+					RemainingSymbolAmount: o.Order.RemainingSymbolAmount,
+				}
+			}
+			res.Orders = append(res.Orders, txOrder)
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_Tx{Tx: res}}, nil
+	case updateproto.Method_DEPTH:
+		depth := &order.Depth{}
+		if err := json.Unmarshal(b, depth); err != nil {
+			return nil, err
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_Depth{Depth: &updateproto.Depth{
+			Symbol:    depth.Symbol,
+			Grouping:  depth.Grouping,
+			Groupings: depth.Groupings,
+			Buy:       depthLevels(depth.Buy),
+			Sell:      depthLevels(depth.Sell),
+			BestBuy:   depth.BestBuy,
+			BestSell:  depth.BestSell,
+			Spread:    depth.Spread,
+			MidPrice:  depth.MidPrice,
+		}}}, nil
+	case updateproto.Method_ORDERS_FOR_ACCOUNT:
+		var events []*dmn.OrderEvent
+		if err := json.Unmarshal(b, &events); err != nil {
+			return nil, err
+		}
+		res := &updateproto.OrderEvents{Events: make([]*updateproto.OrderEvent, 0, len(events))}
+		for _, e := range events {
+			res.Events = append(res.Events, &updateproto.OrderEvent{
+				Event:                 e.OrderEvent,
+				HumanReadablePrice:    e.HumanReadablePrice,
+				SymbolAmount:          e.SymbolAmount,
+				RemainingSymbolAmount: e.RemainingSymbolAmount,
+			})
+		}
+		return &updateproto.Payload{Content: &updateproto.Payload_OrderEvents{OrderEvents: res}}, nil
+	}
+	return nil, fmt.Errorf("unsupported method %s", method)
+}
+
+// ohlcPoints converts the OHLC arrays [timestamp, open, high, low, close, volume]
+func ohlcPoints(b []byte) (*updateproto.OHLCs, error) {
+	var points [][6]json.RawMessage
+	if err := json.Unmarshal(b, &points); err != nil {
+		return nil, err
+	}
+	res := &updateproto.OHLCs{OHLCs: make([]*updateproto.OHLCPoint, 0, len(points))}
+	for _, p := range points {
+		point := &updateproto.OHLCPoint{}
+		values := []*string{&point.Open, &point.High, &point.Low, &point.Close, &point.Volume}
+		if err := json.Unmarshal(p[0], &point.Timestamp); err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if err := json.Unmarshal(p[i+1], v); err != nil {
+				return nil, err
+			}
+		}
+		res.OHLCs = append(res.OHLCs, point)
+	}
+	return res, nil
+}
+
+func tickerPoints(tickers *dmn.Tickers) map[string]*updateproto.TickerPoint {
+	res := make(map[string]*updateproto.TickerPoint)
+	if tickers == nil {
+		return res
+	}
+	for sym, t := range *tickers {
+		if t == nil {
+			continue
+		}
+		res[sym] = &updateproto.TickerPoint{
+			OpenTime:       t.OpenTime,
+			CloseTime:      t.CloseTime,
+			OpenPrice:      t.OpenPrice,
+			HighPrice:      t.HighPrice,
+			LowPrice:       t.LowPrice,
+			LastPrice:      t.LastPrice,
+			FirstPrice:     t.FirstPrice,
+			Volume:         t.Volume,
+			InvertedVolume: t.InvertedVolume,
+			Inverted:       t.Inverted,
+		}
+	}
+	return res
+}
+
+func orderBookOf(orderbook *coreum.OrderBookOrders) *updateproto.OrderBook {
+	res := &updateproto.OrderBook{}
+	if orderbook == nil {
+		return res
+	}
+	res.Buy = orderBookOrders(orderbook.Buy)
+	res.Sell = orderBookOrders(orderbook.Sell)
+	return res
+}
+
+func orderBookOrders(orders []*coreum.OrderBookOrder) []*updateproto.OrderBookOrder {
+	res := make([]*updateproto.OrderBookOrder, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, &updateproto.OrderBookOrder{
+			Price:                 o.Price,
+			HumanReadablePrice:    o.HumanReadablePrice,
+			Amount:                o.Amount,
+			SymbolAmount:          o.SymbolAmount,
+			Sequence:              o.Sequence,
+			Account:               o.Account,
+			OrderID:               o.OrderID,
+			RemainingAmount:       o.RemainingAmount,
+			RemainingSymbolAmount: o.RemainingSymbolAmount,
+		})
+	}
+	return res
+}
+
+func depthLevels(levels []order.DepthLevel) []*updateproto.DepthLevel {
+	res := make([]*updateproto.DepthLevel, 0, len(levels))
+	for _, l := range levels {
+		res = append(res, &updateproto.DepthLevel{
+			Price:                 l.Price,
+			BaseAmount:            l.BaseAmount,
+			QuoteAmount:           l.QuoteAmount,
+			CumulativeBaseAmount:  l.CumulativeBaseAmount,
+			CumulativeQuoteAmount: l.CumulativeQuoteAmount,
+			Orders:                int64(l.Orders),
+		})
+	}
+	return res
+}
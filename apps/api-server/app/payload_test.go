@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+func Test_NewUpdate(t *testing.T) {
+	trades := []*dmn.Trade{{
+		Trade: &tradegrpc.Trade{
+			Account:  "devcore1abc",
+			Sequence: 12,
+			Amount:   &decimal.Decimal{Value: 9007199254740993, Exp: -6},
+			Price:    0.25,
+		},
+		HumanReadablePrice: "0.25",
+		SymbolAmount:       "9007199254.740993",
+		Status:             ordergrpc.OrderStatus_ORDER_STATUS_FILLED,
+	}}
+	content, err := json.Marshal(trades)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &updateproto.Subscribe{
+		Action: updateproto.Action_RESPONSE,
+		Subscription: &updateproto.Subscription{
+			Network:  metadata.Network_DEVNET,
+			Method:   updateproto.Method_TRADES_FOR_ACCOUNT,
+			ID:       "devcore1abc",
+			Sequence: 3,
+			Type:     updateproto.UpdateType_DELTA,
+			Content:  string(content),
+		},
+	}
+	u, err := newUpdate(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The binary message holds the typed payload instead of the content
+	b, err := proto.Marshal(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &updateproto.Update{}
+	if err := proto.Unmarshal(b, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Subscription.Content != "" || decoded.Subscription.Sequence != 3 || decoded.Subscription.Type != updateproto.UpdateType_DELTA {
+		t.Errorf("Unexpected subscription %v", decoded.Subscription)
+	}
+	got := decoded.Payload.GetTrades().GetTrades()
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 trade, got %d", len(got))
+	}
+	// Amounts beyond the precision of a float are kept
+	if got[0].Trade.Amount.Value != 9007199254740993 || got[0].Trade.Sequence != 12 || got[0].SymbolAmount != "9007199254.740993" ||
+		got[0].Status != ordergrpc.OrderStatus_ORDER_STATUS_FILLED {
+		t.Errorf("Unexpected trade %v", got[0])
+	}
+	if len(b) >= len(m.Subscription.Content) {
+		t.Errorf("Expected the binary message (%d bytes) to be smaller than the JSON content (%d bytes)", len(b), len(m.Subscription.Content))
+	}
+
+	// Messages without content (ACK, ERROR) have no payload
+	u, err = newUpdate(&updateproto.Subscribe{Action: updateproto.Action_ERROR, Error: "not subscribed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Payload != nil || u.Error != "not subscribed" {
+		t.Errorf("Unexpected error update %v", u)
+	}
+}
+
+func Test_PayloadOf(t *testing.T) {
+	orderbook, err := json.Marshal(&coreum.OrderBookOrders{
+		Buy:  []*coreum.OrderBookOrder{{Price: "0.25", HumanReadablePrice: "0.25", Amount: "100", Sequence: 1}},
+		Sell: []*coreum.OrderBookOrder{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := payloadOf(updateproto.Method_ORDERBOOK, string(orderbook))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buy := payload.GetOrderBook().GetBuy(); len(buy) != 1 || buy[0].Price != "0.25" || buy[0].Sequence != 1 {
+		t.Errorf("Unexpected order book %v", payload.GetOrderBook())
+	}
+
+	payload, err = payloadOf(updateproto.Method_OHLC, `[[1676937600,"0.404","0.416","0.39","0.398","1442749.5"]]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ohlcs := payload.GetOHLCs().GetOHLCs()
+	if len(ohlcs) != 1 || ohlcs[0].Timestamp != 1676937600 || ohlcs[0].Open != "0.404" || ohlcs[0].Volume != "1442749.5" {
+		t.Errorf("Unexpected OHLC %v", ohlcs)
+	}
+
+	if _, err := payloadOf(updateproto.Method_OHLC, `{"invalid":true}`); err == nil {
+		t.Errorf("Expected an error for invalid content")
+	}
+}
+
+func Test_MessageEncoded(t *testing.T) {
+	m := newMessage(&updateproto.Subscribe{
+		Action: updateproto.Action_RESPONSE,
+		Subscription: &updateproto.Subscription{
+			Network: metadata.Network_DEVNET,
+			Method:  updateproto.Method_OHLC,
+			ID:      "ucore_uusdc_1m",
+			Type:    updateproto.UpdateType_SNAPSHOT,
+			Content: `{"invalid":true}`,
+		},
+	})
+	b, err := m.encoded()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The listeners share the encoding
+	if again, _ := m.encoded(); &again[0] != &b[0] {
+		t.Errorf("Expected the encoding to be shared")
+	}
+	// A content which can not be converted is sent as error frame for the subscription
+	decoded := &updateproto.Update{}
+	if err := proto.Unmarshal(b, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Action != updateproto.Action_ERROR || decoded.Error == "" || decoded.Payload != nil ||
+		decoded.Subscription.Method != updateproto.Method_OHLC || decoded.Subscription.ID != "ucore_uusdc_1m" {
+		t.Errorf("Unexpected error frame %v", decoded)
+	}
+}
@@ -0,0 +1,262 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// fetchFunc retrieves the content of a channel
+type fetchFunc func(ctx context.Context, subscription *updateproto.Subscription, changes *updateproto.Changes, now time.Time) (*channelRefresh, error)
+
+// changesSource streams the changes of all networks into the events until the context is done
+type changesSource func(ctx context.Context, events chan<- changesEvent)
+
+// changesEvent is either changes received from the store, or a resync after (re)connecting to the changes
+type changesEvent struct {
+	changes *updateproto.Changes
+	resync  bool
+}
+
+// channelInterest are the replicas with listeners for a channel
+type channelInterest struct {
+	subscription *updateproto.Subscription
+	replicas     map[string]time.Time // Last announcement per replica
+}
+
+// producer computes the updates of the channels with listeners in any of the replicas, and publishes them to all
+// replicas. Only the elected replica runs the producer, so every update is computed once.
+// All state is owned by the go routine running the producer.
+type producer struct {
+	broker   broker.Broker
+	fetch    fetchFunc
+	channels map[string]*channel
+	interest map[string]*channelInterest
+	// Replicas which stopped announcing their subscriptions are gone
+	interestExpiry time.Duration
+	tickerRefresh  int // Counter based on the REFRESH_INTERVAL
+}
+
+func newProducer(b broker.Broker, fetch fetchFunc, interestExpiry time.Duration, tickerRefresh int) *producer {
+	return &producer{
+		broker:         b,
+		fetch:          fetch,
+		channels:       make(map[string]*channel),
+		interest:       make(map[string]*channelInterest),
+		interestExpiry: interestExpiry,
+		tickerRefresh:  tickerRefresh,
+	}
+}
+
+// run produces the updates until the context is done (leadership lost).
+// The content of a channel is refreshed when the changes published by the data-aggregator for a block (or for the
+// OHLC) can have affected it.
+func (p *producer) run(ctx context.Context, source changesSource) {
+	requests, err := p.broker.Subscribe(ctx, REQUESTS_TOPIC)
+	if err != nil {
+		logger.Errorf("Subscribing to the websocket requests failed: %v", err)
+		return
+	}
+	events := make(chan changesEvent, CHANGES_BUFFER)
+	go source(ctx, events)
+	refreshTicker := time.NewTicker(REFRESH_INTERVAL)
+	defer refreshTicker.Stop()
+	// Last block height received per network, to detect missed changes
+	heights := make(map[metadata.Network]int64)
+	refreshCounter := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case data, ok := <-requests:
+			if !ok {
+				return
+			}
+			batch := []*request{}
+			// Handle all pending requests together so that a channel is only retrieved once
+			for pending := true; pending; {
+				r := &request{}
+				if err := json.Unmarshal(data, r); err != nil {
+					logger.Warnf("Invalid websocket request: %v", err)
+				} else {
+					batch = append(batch, r)
+				}
+				select {
+				case data, ok = <-requests:
+					pending = ok
+				default:
+					pending = false
+				}
+			}
+			p.handleRequests(ctx, batch)
+		case event := <-events:
+			if event.resync {
+				// (Re)connected to the changes: Changes can have been missed
+				heights = make(map[metadata.Network]int64)
+				p.refreshChannels(ctx, nil, func(*channel) bool { return true })
+				continue
+			}
+			changes := event.changes
+			if changes.BlockHeight > 0 {
+				last := heights[changes.Network]
+				heights[changes.Network] = changes.BlockHeight
+				if last > 0 && changes.BlockHeight > last+1 {
+					logger.Warnf("Missed the changes of blocks %d-%d of %s, refreshing all channels", last+1, changes.BlockHeight-1, changes.Network)
+					p.refreshChannels(ctx, nil, func(c *channel) bool { return c.subscription.Network == changes.Network })
+					continue
+				}
+			}
+			p.refreshChannels(ctx, changes, func(c *channel) bool { return affected(c.subscription, changes) })
+		case <-refreshTicker.C:
+			refreshCounter++
+			p.expireInterest(time.Now())
+			p.refreshChannels(ctx, nil, func(c *channel) bool {
+				return refreshCounter%RESYNC_REFRESH == 0 ||
+					// dynamic refresh interval based on the cache duration of the tickers
+					c.subscription.Method == updateproto.Method_TICKER && refreshCounter%p.tickerRefresh == 0 ||
+					// The balances also change by transfers, which are not part of the changes
+					c.subscription.Method == updateproto.Method_WALLET && refreshCounter%WALLET_REFRESH == 0
+			})
+		}
+	}
+}
+
+// handleRequests registers the subscriptions of the replicas and sends the requested snapshots.
+// Channels without state are retrieved first, concurrently.
+func (p *producer) handleRequests(ctx context.Context, requests []*request) {
+	now := time.Now()
+	for _, r := range requests {
+		announced := make(map[string]bool)
+		for _, subscription := range r.Subscriptions {
+			key := channelKey(subscription)
+			announced[key] = true
+			if p.interest[key] == nil {
+				p.interest[key] = &channelInterest{subscription: subscription, replicas: make(map[string]time.Time)}
+			}
+			p.interest[key].replicas[r.Replica] = now
+			if p.channels[key] == nil {
+				p.channels[key] = newChannel(subscription)
+			}
+		}
+		if r.Snapshot {
+			continue
+		}
+		// An announcement holds all subscriptions of the replica
+		for key, interest := range p.interest {
+			if !announced[key] {
+				delete(interest.replicas, r.Replica)
+			}
+		}
+	}
+	p.expireInterest(now)
+	wg := sync.WaitGroup{}
+	retrieving := make(map[string]bool)
+	for _, r := range requests {
+		if !r.Snapshot {
+			continue
+		}
+		for _, subscription := range r.Subscriptions {
+			key := channelKey(subscription)
+			c, ok := p.channels[key]
+			if !ok || c.initialized || retrieving[key] {
+				continue
+			}
+			retrieving[key] = true
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				refresh, err := p.fetch(ctx, c.subscription, nil, now)
+				if err != nil {
+					logger.Errorf("Error retrieving %s: %v", key, err)
+					return
+				}
+				c.apply(refresh)
+			}()
+		}
+	}
+	wg.Wait()
+	for _, r := range requests {
+		if !r.Snapshot {
+			continue
+		}
+		for _, subscription := range r.Subscriptions {
+			c, ok := p.channels[channelKey(subscription)]
+			// Not retrieved: The listeners receive the snapshot with the next successful refresh
+			if !ok || !c.initialized {
+				continue
+			}
+			p.publish(ctx, r.Replica, c.message(c.snapshot(), updateproto.UpdateType_SNAPSHOT))
+		}
+	}
+}
+
+// expireInterest removes the replicas which stopped announcing their subscriptions, and the channels without
+// listeners in any replica
+func (p *producer) expireInterest(now time.Time) {
+	for key, interest := range p.interest {
+		for replica, announced := range interest.replicas {
+			if now.Sub(announced) > p.interestExpiry {
+				delete(interest.replicas, replica)
+			}
+		}
+		if len(interest.replicas) == 0 {
+			delete(p.interest, key)
+			delete(p.channels, key)
+		}
+	}
+}
+
+// refreshChannels retrieves the content of the selected channels concurrently and publishes the changed content.
+// changes is nil for a full refresh.
+func (p *producer) refreshChannels(ctx context.Context, changes *updateproto.Changes, selected func(*channel) bool) {
+	now := time.Now()
+	wg := sync.WaitGroup{}
+	for key, c := range p.channels {
+		// Channels without content (e.g. announced to a new producer) are retrieved with every refresh
+		if c.initialized && !selected(c) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r, err := p.fetch(ctx, c.subscription, changes, now)
+			if err != nil {
+				logger.Errorf("Error retrieving %s: %v", key, err)
+				return
+			}
+			updateType := updateproto.UpdateType_DELTA
+			if !c.initialized {
+				updateType = updateproto.UpdateType_SNAPSHOT
+			}
+			content, changed := c.apply(r)
+			if !changed && updateType == updateproto.UpdateType_DELTA {
+				return
+			}
+			if updateType == updateproto.UpdateType_SNAPSHOT {
+				content = c.snapshot()
+			} else {
+				c.sequence++
+			}
+			p.publish(ctx, "", c.message(content, updateType))
+		}()
+	}
+	wg.Wait()
+}
+
+// publish sends the message to the listeners of the channel in the replica, in all replicas if replica is empty
+func (p *producer) publish(ctx context.Context, replica string, m *updateproto.Subscription) {
+	b, err := json.Marshal(&update{Replica: replica, Subscription: m})
+	if err != nil {
+		logger.Errorf("Error marshalling the update of %s: %v", channelKey(m), err)
+		return
+	}
+	if err := p.broker.Publish(ctx, UPDATES_TOPIC, b); err != nil {
+		logger.Errorf("Publishing the update of %s failed: %v", channelKey(m), err)
+	}
+}
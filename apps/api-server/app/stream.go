@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// eventStream is a Server-Sent Events (text/event-stream) connection with a single subscription, for the clients
+// which can not use a websocket (e.g. behind a proxy which blocks the upgrade).
+// The hub writes the messages of the subscription to the stream as to a websocket: The snapshot, followed by the deltas.
+type eventStream struct {
+	w      io.Writer
+	rc     *http.ResponseController
+	remote string
+	done   chan struct{}
+
+	mutex  sync.Mutex
+	closed bool
+	// Sequence of the last event the client received, from the Last-Event-ID of the request when resuming
+	last     int64
+	received bool
+}
+
+func newEventStream(w http.ResponseWriter, remote, lastEventID string) *eventStream {
+	s := &eventStream{
+		w:      w,
+		rc:     http.NewResponseController(w),
+		remote: remote,
+		done:   make(chan struct{}),
+	}
+	if sequence, err := strconv.ParseInt(lastEventID, 10, 64); err == nil {
+		s.last = sequence
+		s.received = true
+	}
+	return s
+}
+
+// Stream subscribes the event stream of the request to the channel of the subscription and writes its messages until
+// the client disconnects or does not keep up with its messages.
+// Every message is an event with the sequence as ID, the update type (snapshot or delta) as event and the content as
+// data. A client which resumes with the sequence of the current snapshot (Last-Event-ID) does not receive the snapshot
+// again, only the deltas which follow. An error is returned (before anything is written) for an invalid subscription.
+func (app *Application) Stream(ctx context.Context, w http.ResponseWriter, remote string, subscription *updateproto.Subscription, lastEventID string, heartbeat time.Duration) error {
+	if err := validateSubscription(subscription); err != nil {
+		return err
+	}
+	s := newEventStream(w, remote, lastEventID)
+	if err := s.open(w); err != nil {
+		logger.Infof("Error opening event stream %s: %v", remote, err)
+		return nil
+	}
+	app.hub.addSocket(s)
+	// No writes to the response once the handler returned
+	defer s.Close()
+	defer app.hub.close(s)
+	if err := app.hub.subscribe(s, subscription, 0); err != nil {
+		logger.Warnf("Error subscribing event stream %s: %v", remote, err)
+		return nil
+	}
+	// The heartbeats keep the connection open through proxies which close idle connections
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.done:
+			return nil
+		case <-ticker.C:
+			if err := s.heartbeat(); err != nil {
+				logger.Infof("Error sending the heartbeat to event stream %s: %v", remote, err)
+				return nil
+			}
+		}
+	}
+}
+
+// open sends the headers of the stream
+func (s *eventStream) open(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disables the response buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	return s.write("")
+}
+
+// send writes the response as event. The acknowledgement of the subscription is not sent: The stream is subscribed by
+// the request. A snapshot with the sequence the client already received is skipped.
+func (s *eventStream) send(m *updateproto.Subscribe) error {
+	if m.Action != updateproto.Action_RESPONSE || m.Subscription == nil {
+		return nil
+	}
+	subscription := m.Subscription
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return nil
+	}
+	if subscription.Type == updateproto.UpdateType_SNAPSHOT && s.received && subscription.Sequence == s.last {
+		return nil
+	}
+	s.last = subscription.Sequence
+	s.received = true
+	var event strings.Builder
+	fmt.Fprintf(&event, "id: %d\nevent: %s\n", subscription.Sequence, strings.ToLower(subscription.Type.String()))
+	for _, line := range strings.Split(subscription.Content, "\n") {
+		fmt.Fprintf(&event, "data: %s\n", line)
+	}
+	event.WriteString("\n")
+	return s.write(event.String())
+}
+
+// heartbeat writes a comment, which is ignored by the clients
+func (s *eventStream) heartbeat() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.closed {
+		return nil
+	}
+	return s.write(": heartbeat\n\n")
+}
+
+// write writes and flushes the event. A client which does not read blocks the write: Fail instead of blocking the sender
+// of the stream.
+func (s *eventStream) write(event string) error {
+	if err := s.rc.SetWriteDeadline(time.Now().Add(WRITE_TIMEOUT)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := io.WriteString(s.w, event); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// Close ends the stream: Stream returns and the request is completed
+func (s *eventStream) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+	return nil
+}
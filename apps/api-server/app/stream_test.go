@@ -0,0 +1,89 @@
+package app
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+func Test_EventStream(t *testing.T) {
+	response := func(sequence int64, updateType updateproto.UpdateType, content string) *updateproto.Subscribe {
+		return &updateproto.Subscribe{
+			Action: updateproto.Action_RESPONSE,
+			Subscription: &updateproto.Subscription{
+				Method:   updateproto.Method_ORDERBOOK,
+				ID:       "ucore_uusdc",
+				Sequence: sequence,
+				Type:     updateType,
+				Content:  content,
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		lastEventID string
+		messages    []*updateproto.Subscribe
+		expected    string
+	}{
+		{
+			name: "snapshot and delta",
+			messages: []*updateproto.Subscribe{
+				{Action: updateproto.Action_ACK, Subscription: &updateproto.Subscription{Method: updateproto.Method_ORDERBOOK}},
+				response(4, updateproto.UpdateType_SNAPSHOT, `{"Buy":[]}`),
+				response(5, updateproto.UpdateType_DELTA, `{"Buy":[1]}`),
+			},
+			expected: "id: 4\nevent: snapshot\ndata: {\"Buy\":[]}\n\nid: 5\nevent: delta\ndata: {\"Buy\":[1]}\n\n",
+		},
+		{
+			name:        "resume with the sequence of the snapshot",
+			lastEventID: "4",
+			messages: []*updateproto.Subscribe{
+				response(4, updateproto.UpdateType_SNAPSHOT, `{"Buy":[]}`),
+				response(5, updateproto.UpdateType_DELTA, `{"Buy":[1]}`),
+			},
+			expected: "id: 5\nevent: delta\ndata: {\"Buy\":[1]}\n\n",
+		},
+		{
+			name:        "resume with a missed delta",
+			lastEventID: "3",
+			messages: []*updateproto.Subscribe{
+				response(4, updateproto.UpdateType_SNAPSHOT, "{\n}"),
+			},
+			expected: "id: 4\nevent: snapshot\ndata: {\ndata: }\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s := newEventStream(w, "test", tt.lastEventID)
+			if err := s.open(w); err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range tt.messages {
+				if err := s.send(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if w.Header().Get("Content-Type") != "text/event-stream" {
+				t.Errorf("Unexpected content type %s", w.Header().Get("Content-Type"))
+			}
+			if w.Body.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, w.Body.String())
+			}
+		})
+	}
+
+	// Nothing is written once the stream is closed
+	w := httptest.NewRecorder()
+	s := newEventStream(w, "test", "")
+	s.Close()
+	if err := s.send(response(1, updateproto.UpdateType_SNAPSHOT, "{}")); err != nil || w.Body.Len() != 0 {
+		t.Errorf("Unexpected write to a closed stream: %q, %v", w.Body.String(), err)
+	}
+	select {
+	case <-s.done:
+	default:
+		t.Errorf("Expected the stream to be done")
+	}
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	dec "github.com/shopspring/decimal"
+
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+var (
+	ErrSubscriptionLimit = errors.New("subscription limit of the connection reached")
+	ErrNotSubscribed     = errors.New("not subscribed")
+
+	txHashRegex = regexp.MustCompile(`^(?:0x)?[0-9a-fA-F]{64}$`)
+)
+
+// validateSubscription checks the network, the method and the format of the ID of the method
+func validateSubscription(subscription *updateproto.Subscription) error {
+	if subscription == nil {
+		return errors.New("subscription is missing")
+	}
+	if _, ok := metadata.Network_name[int32(subscription.Network)]; !ok || subscription.Network == metadata.Network_NETWORK_DO_NOT_USE {
+		return fmt.Errorf("unknown network %d", subscription.Network)
+	}
+	if _, ok := updateproto.Method_name[int32(subscription.Method)]; !ok || subscription.Method == updateproto.Method_METHOD_DO_NOT_USE {
+		return fmt.Errorf("unknown method %d", subscription.Method)
+	}
+	if subscription.ID == "" {
+		return errors.New("ID is missing")
+	}
+	var err error
+	switch subscription.Method {
+	case updateproto.Method_TRADES_FOR_SYMBOL,
+		updateproto.Method_TICKER,
+		updateproto.Method_ORDERBOOK:
+		_, err = symbol.NewSymbol(subscription.ID)
+	case updateproto.Method_TRADES_FOR_ACCOUNT,
+		updateproto.Method_WALLET,
+		updateproto.Method_ORDERBOOKS_FOR_ACCOUNT:
+		err = validateAccount(subscription.ID)
+	case updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL,
+		updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT:
+		account, sym := splitAccount(subscription.ID)
+		if err = validateAccount(account); err == nil {
+			_, err = symbol.NewSymbol(sym)
+		}
+	case updateproto.Method_ORDERS_FOR_ACCOUNT:
+		// The symbol is optional
+		account, sym := splitAccount(subscription.ID)
+		if err = validateAccount(account); err == nil && sym != "" {
+			_, err = symbol.NewSymbol(sym)
+		}
+	case updateproto.Method_OHLC:
+		parts := strings.Split(subscription.ID, "_")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid ID %s, expected {denom1}_{denom2}_{interval}", subscription.ID)
+		}
+		if _, err = symbol.NewSymbol(parts[0] + "_" + parts[1]); err == nil {
+			if _, err = dmn.HttpPeriodToPeriod(parts[2]); err != nil {
+				err = fmt.Errorf("invalid interval %s", parts[2])
+			}
+		}
+	case updateproto.Method_DEPTH:
+		parts := strings.Split(subscription.ID, "_")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid ID %s, expected {denom1}_{denom2}_{grouping}", subscription.ID)
+		}
+		if _, err = symbol.NewSymbol(parts[0] + "_" + parts[1]); err == nil {
+			var grouping dec.Decimal
+			if grouping, err = dec.NewFromString(parts[2]); err == nil && !grouping.IsPositive() {
+				err = fmt.Errorf("invalid grouping %s", parts[2])
+			}
+		}
+	case updateproto.Method_TX_STATUS:
+		if !txHashRegex.MatchString(subscription.ID) {
+			return fmt.Errorf("invalid transaction hash %s", subscription.ID)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid ID %s for %s: %w", subscription.ID, subscription.Method, err)
+	}
+	return nil
+}
+
+func validateAccount(account string) error {
+	if account == "" || strings.ContainsAny(account, "_ ") {
+		return fmt.Errorf("invalid account %s", account)
+	}
+	return nil
+}
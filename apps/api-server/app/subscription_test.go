@@ -0,0 +1,47 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+func Test_ValidateSubscription(t *testing.T) {
+	tests := []struct {
+		method updateproto.Method
+		id     string
+		valid  bool
+	}{
+		{updateproto.Method_TRADES_FOR_SYMBOL, "udevcore_usara-devcore1abc", true},
+		{updateproto.Method_TRADES_FOR_SYMBOL, "udevcore", false},
+		{updateproto.Method_TRADES_FOR_ACCOUNT, "devcore1abc", true},
+		{updateproto.Method_TRADES_FOR_ACCOUNT, "devcore1abc_udevcore", false},
+		{updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL, "devcore1abc_udevcore_usara-devcore1abc", true},
+		{updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL, "devcore1abc", false},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "devcore1abc", true},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "devcore1abc_udevcore_usara-devcore1abc", true},
+		{updateproto.Method_ORDERS_FOR_ACCOUNT, "devcore1abc_udevcore", false},
+		{updateproto.Method_OHLC, "udevcore_usara-devcore1abc_1m", true},
+		{updateproto.Method_OHLC, "udevcore_usara-devcore1abc_2m", false},
+		{updateproto.Method_DEPTH, "udevcore_usara-devcore1abc_0.01", true},
+		{updateproto.Method_DEPTH, "udevcore_usara-devcore1abc_0", false},
+		{updateproto.Method_TX_STATUS, "E1E3674A0E4E1EF9C69646F9AF8D9497173821826074622D831BAB73CCB99A2D", true},
+		{updateproto.Method_TX_STATUS, "E1E3", false},
+		{updateproto.Method_WALLET, "", false},
+		{updateproto.Method_METHOD_DO_NOT_USE, "devcore1abc", false},
+		{updateproto.Method(100), "devcore1abc", false},
+	}
+	for _, tt := range tests {
+		err := validateSubscription(&updateproto.Subscription{Network: metadata.Network_DEVNET, Method: tt.method, ID: tt.id})
+		if (err == nil) != tt.valid {
+			t.Errorf("%s %s: expected valid %v, got %v", tt.method, tt.id, tt.valid, err)
+		}
+	}
+	if err := validateSubscription(&updateproto.Subscription{Method: updateproto.Method_WALLET, ID: "devcore1abc"}); err == nil {
+		t.Errorf("Expected an error for the missing network")
+	}
+	if err := validateSubscription(nil); err == nil {
+		t.Errorf("Expected an error for the missing subscription")
+	}
+}
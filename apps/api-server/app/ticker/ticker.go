@@ -298,6 +298,20 @@ func (s *Application) getRate(ctx context.Context, symbol string, network metada
 	return usd, nil
 }
 
+// USDRate returns the rate of the denom in subunits of the USDC denom (also returned) per subunit of the denom.
+// The rate is 0 (without error) if the denom can not be converted to USDC.
+func (s *Application) USDRate(ctx context.Context, network metadata.Network, den string) (float64, *denom.Denom, error) {
+	fetcher, ok := (*s.rates)[network]
+	if !ok || fetcher.USDC() == nil {
+		return 0.0, nil, nil
+	}
+	usd, err := s.getRate(ctx, den, network)
+	if err != nil {
+		return 0.0, nil, err
+	}
+	return usd, fetcher.USDC(), nil
+}
+
 func (s *Application) GetUSDRates(ctx context.Context, opt *dmn.TickerReadOptions) map[string]float64 {
 	// Key is the symbol.String in the input order (e.g. no inversion of the symbol required)
 	rates := make(map[string]float64)
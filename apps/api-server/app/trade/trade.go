@@ -11,6 +11,7 @@ import (
 
 	currency "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/currency"
 	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/cursor"
 	decimal "github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
 	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	ordergrpcclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
@@ -27,6 +28,9 @@ type Application struct {
 
 type Trades []*dmn.Trade
 
+// maxFills limits the number of fills returned for a single order
+const maxFills = 1000
+
 func NewApplication(currencyClient *currency.Application) *Application {
 	app := &Application{
 		tradeClient:    tradegrpclient.Client(),
@@ -36,6 +40,12 @@ func NewApplication(currencyClient *currency.Application) *Application {
 	return app
 }
 
+// TradesPage is a page of trades with the cursor to retrieve the next page (nil if there are no more trades)
+type TradesPage struct {
+	Trades     Trades
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
 // The trades list consists of trades that are filled and cancelled.
 // Cancelled trades are in the order data, while executed trades are in the trade data. Both need to be combined.
 // Infinite scroll is done by using the from/to avoiding offset and data join issues
@@ -43,30 +53,111 @@ func NewApplication(currencyClient *currency.Application) *Application {
 // Data is calculated where required: The Trades can be provided inverted compared to the requested values
 // The values which are not in the order of the requested values are recalculated.
 func (app *Application) GetTrades(ctx context.Context, filter *tradegrpc.Filter) (*Trades, error) {
-	trades, err := app.getTrades(ctx, filter)
+	page, err := app.GetTradesPage(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
+	return &page.Trades, nil
+}
 
-	cancelledOrders, err := app.GetCancelledOrders(ctx, filter)
+// GetTradesPage returns the trades (including the cancelled orders) for the given filter and the cursor for the next page.
+// The trades and the cancelled orders are merged in the order of the cursor (BlockHeight, Sequence and TXID descending),
+// so that each cancelled order is returned exactly once over all pages and a page holds at most the requested limit.
+// A page retrieves at most limit cancelled orders, continuing after the last cancelled order of the previous page.
+func (app *Application) GetTradesPage(ctx context.Context, filter *tradegrpc.Filter) (*TradesPage, error) {
+	var current *cursor.Cursor
+	var err error
+	if filter.Cursor != nil && *filter.Cursor != "" {
+		if current, err = cursor.Decode(*filter.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	limit := 0
+	if filter.Limit != nil {
+		limit = int(*filter.Limit)
+	}
+	trades, nextCursor, err := app.getTrades(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	cancelledOrders, moreCancelled, err := app.GetCancelledOrders(ctx, filter, current, limit)
 	if err != nil {
 		return nil, err
 	}
-	// append the cancelled orders to the trades list
+	return mergePage(*trades, cancelledOrders, current, nextCursor != nil, moreCancelled, limit), nil
+}
+
+// mergePage merges the cancelled orders after the current cursor into the page of trades and limits the page (0 is
+// unlimited). With more trades after the page, the cancelled orders after the last trade are left to the next pages,
+// since their position relative to the trades which are not retrieved is unknown. The same applies to the trades
+// after the last cancelled order with more cancelled orders after the page.
+// The cursor for the next page is the position of the last returned trade or cancelled order.
+func mergePage(trades Trades, cancelledOrders []*dmn.Trade, current *cursor.Cursor, more, moreCancelled bool, limit int) *TradesPage {
+	var last, lastCancelled *cursor.Cursor
+	if more && len(trades) > 0 {
+		last = positionOf(trades[len(trades)-1])
+	}
+	if moreCancelled && len(cancelledOrders) > 0 {
+		lastCancelled = positionOf(cancelledOrders[len(cancelledOrders)-1])
+		more = true
+	}
+	merged := make(Trades, 0, len(trades)+len(cancelledOrders))
+	for _, trade := range trades {
+		if lastCancelled == nil || !before(lastCancelled, positionOf(trade)) {
+			merged = append(merged, trade)
+		}
+	}
 	for _, order := range cancelledOrders {
-		*trades = append(*trades, order)
+		position := positionOf(order)
+		if (current != nil && !before(current, position)) || (last != nil && before(last, position)) {
+			continue
+		}
+		merged = append(merged, order)
 	}
-	// Order the trades by blockheight descending
-	sort.Slice(*trades, func(i, j int) bool {
-		return (*trades)[i].BlockHeight > (*trades)[j].BlockHeight
+	sort.SliceStable(merged, func(i, j int) bool {
+		return before(positionOf(merged[i]), positionOf(merged[j]))
 	})
-	return trades, nil
+	page := &TradesPage{Trades: merged}
+	if limit > 0 && len(merged) > limit {
+		page.Trades = merged[:limit]
+		more = true
+	}
+	if more && len(page.Trades) > 0 {
+		next := positionOf(page.Trades[len(page.Trades)-1])
+		if current != nil {
+			next.Cancelled = current.Cancelled
+		}
+		for _, trade := range page.Trades {
+			if trade.Status == ordergrpc.OrderStatus_ORDER_STATUS_CANCELED {
+				next.Cancelled = trade.Sequence
+			}
+		}
+		encoded := next.Encode()
+		page.NextCursor = &encoded
+	}
+	return page
+}
+
+// positionOf returns the position of the trade in the sort order of the trades
+func positionOf(trade *dmn.Trade) *cursor.Cursor {
+	return &cursor.Cursor{BlockHeight: trade.BlockHeight, Sequence: trade.Sequence, TXID: trade.GetTXID()}
 }
 
-func (app *Application) getTrades(ctx context.Context, filter *tradegrpc.Filter) (*Trades, error) {
+// before returns true if position a precedes position b (BlockHeight, Sequence and TXID descending)
+func before(a, b *cursor.Cursor) bool {
+	if a.BlockHeight != b.BlockHeight {
+		return a.BlockHeight > b.BlockHeight
+	}
+	if a.Sequence != b.Sequence {
+		return a.Sequence > b.Sequence
+	}
+	return a.TXID > b.TXID
+}
+
+func (app *Application) getTrades(ctx context.Context, filter *tradegrpc.Filter) (*Trades, *string, error) {
 	trades, err := app.tradeClient.GetAll(tradegrpclient.AuthCtx(ctx), filter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	trs := Trades(make([]*dmn.Trade, 0))
 	// cast trs into Trades type:
@@ -80,14 +171,69 @@ func (app *Application) getTrades(ctx context.Context, filter *tradegrpc.Filter)
 		tr.Status = ordergrpc.OrderStatus_ORDER_STATUS_FILLED
 		trs = append(trs, tr)
 	}
-	return &trs, nil
+	return &trs, trades.NextCursor, nil
+}
+
+// GetOrderDetail adds the fills of the order (the trades sharing the sequence of the order) with the average fill price
+// and filled percentage. The fills are ordered oldest first to show the timeline of the execution of the order.
+func (app *Application) GetOrderDetail(ctx context.Context, order *dmn.Order) (*dmn.OrderDetail, error) {
+	fills, _, err := app.getTrades(ctx, &tradegrpc.Filter{
+		Network:  order.MetaData.Network,
+		Sequence: &order.Sequence,
+		Denom1:   order.BaseDenom,
+		Denom2:   order.QuoteDenom,
+		Limit:    lo.ToPtr(int32(maxFills)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(*fills, func(i, j int) bool {
+		return (*fills)[i].BlockHeight < (*fills)[j].BlockHeight
+	})
+	averagePrice, filledPercentage := fillSummary(order.Quantity, *fills)
+	return &dmn.OrderDetail{
+		Order:            order,
+		Fills:            *fills,
+		AverageFillPrice: averagePrice.String(),
+		FilledPercentage: filledPercentage.String(),
+	}, nil
+}
+
+// fillSummary returns the average fill price (weighted by the filled amount) and the filled percentage of the order quantity.
+// The fill amounts and the order quantity are both in subunits of the base denom.
+func fillSummary(quantity *decimal.Decimal, fills Trades) (dec.Decimal, dec.Decimal) {
+	filled := dec.Zero
+	weightedPrice := dec.Zero
+	for _, fill := range fills {
+		price, err := dec.NewFromString(fill.HumanReadablePrice)
+		if err != nil {
+			continue
+		}
+		amount := dec.New(fill.Amount.Value, fill.Amount.Exp)
+		filled = filled.Add(amount)
+		weightedPrice = weightedPrice.Add(price.Mul(amount))
+	}
+	if filled.IsZero() {
+		return dec.Zero, dec.Zero
+	}
+	filledPercentage := dec.Zero
+	if quantity != nil {
+		q := dec.New(quantity.Value, quantity.Exp)
+		if !q.IsZero() {
+			filledPercentage = filled.Div(q).Mul(dec.NewFromInt(100)).Round(2)
+		}
+	}
+	return weightedPrice.Div(filled), filledPercentage
 }
 
-// GetCancelledOrders returns all orders that are cancelled. It transforms the trade filter into an order filter for correct results.
+// GetCancelledOrders returns the orders that are cancelled. It transforms the trade filter into an order filter for correct results.
 // The filter is only active if we have an Account in the filter
-func (app *Application) GetCancelledOrders(ctx context.Context, filter *tradegrpc.Filter) ([]*dmn.Trade, error) {
+// At most limit (0 is unlimited) orders are returned, below the sequence of the last cancelled order of the current
+// cursor, and whether there are more. The orders keep the block height at which they were placed and the sequence
+// increases with the placement, so the orders by sequence are in the order of the trade cursor.
+func (app *Application) GetCancelledOrders(ctx context.Context, filter *tradegrpc.Filter, current *cursor.Cursor, limit int) ([]*dmn.Trade, bool, error) {
 	if filter.Account == nil || *filter.Account == "" {
-		return nil, nil
+		return nil, false, nil
 	}
 	orderFilter := &ordergrpc.Filter{
 		Account:     filter.Account,
@@ -96,19 +242,28 @@ func (app *Application) GetCancelledOrders(ctx context.Context, filter *tradegrp
 		To:          filter.To,
 		Network:     filter.Network,
 	}
+	if current != nil && current.Cancelled != 0 {
+		orderFilter.Cursor = lo.ToPtr((&cursor.Cursor{Sequence: current.Cancelled}).Encode())
+	}
+	if limit > 0 {
+		orderFilter.Limit = lo.ToPtr(int32(limit))
+	}
 	orders, err := app.orderClient.GetAll(ctx, orderFilter)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	// Map the orders into trades:
 	trades := make([]*dmn.Trade, 0)
 	for _, order := range orders.Orders {
 		tr := &dmn.Trade{}
 		tr.Trade = &tradegrpc.Trade{
-			Price:  order.Price,
-			Amount: order.Quantity,
-			Denom1: order.BaseDenom,
-			Denom2: order.QuoteDenom,
+			Price:       order.Price,
+			Amount:      order.Quantity,
+			Denom1:      order.BaseDenom,
+			Denom2:      order.QuoteDenom,
+			BlockHeight: order.BlockHeight,
+			Sequence:    order.Sequence,
+			TXID:        order.TXID,
 		}
 		if strings.Compare(tr.Trade.Denom1.Denom, filter.Denom1.Denom) != 0 {
 			tr.Trade.Denom1, tr.Trade.Denom2 = tr.Trade.Denom2, tr.Trade.Denom1
@@ -122,7 +277,7 @@ func (app *Application) GetCancelledOrders(ctx context.Context, filter *tradegrp
 		tr.Status = order.OrderStatus
 		trades = append(trades, tr)
 	}
-	return trades, nil
+	return trades, orders.NextCursor != nil, nil
 }
 
 // Returns human readable price and amount
@@ -2,13 +2,18 @@ package trade
 
 import (
 	"context"
-	"fmt"
+	"errors"
+
+	dec "github.com/shopspring/decimal"
 
 	metadata "github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	dmnsymbol "github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
 	dmntrade "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 )
 
+// ErrMarketNotFound is returned by GetMarket for a symbol without (a unique) trade pair, e.g. a market without trades
+var ErrMarketNotFound = errors.New("trade pair not found")
+
 func (app *Application) GetMarket(ctx context.Context, symbol *dmnsymbol.Symbol, network metadata.Network) (*dmntrade.TradePair, error) {
 	tps, err := app.tradeClient.GetTradePairs(ctx, &dmntrade.TradePairFilter{
 		Denom1:  symbol.Denom1,
@@ -19,7 +24,38 @@ func (app *Application) GetMarket(ctx context.Context, symbol *dmnsymbol.Symbol,
 		return nil, err
 	}
 	if len(tps.TradePairs) == 0 || len(tps.TradePairs) > 1 {
-		return nil, fmt.Errorf("trade pair not found")
+		return nil, ErrMarketNotFound
 	}
 	return tps.TradePairs[0], nil
 }
+
+// ValidateTickAndStep checks the price and quantity (both in subunits) against the price tick and quantity step of the trade pair.
+// Returns the nearest valid price and quantity and if the provided values were valid.
+// A zero price (market order) or a trade pair without price tick or quantity step is not validated.
+func ValidateTickAndStep(tp *dmntrade.TradePair, price, quantity dec.Decimal) (dec.Decimal, dec.Decimal, bool) {
+	valid := true
+	if tp.PriceTick != nil && !price.IsZero() {
+		priceTick := dec.New(tp.PriceTick.Value, tp.PriceTick.Exp)
+		if !priceTick.IsZero() && !price.Mod(priceTick).IsZero() {
+			price = nearestMultiple(price, priceTick)
+			valid = false
+		}
+	}
+	if tp.QuantityStep != nil && *tp.QuantityStep > 0 {
+		quantityStep := dec.NewFromInt(*tp.QuantityStep)
+		if !quantity.Mod(quantityStep).IsZero() {
+			quantity = nearestMultiple(quantity, quantityStep)
+			valid = false
+		}
+	}
+	return price, quantity, valid
+}
+
+// nearestMultiple rounds the value to the nearest multiple of step (with step as minimum)
+func nearestMultiple(value, step dec.Decimal) dec.Decimal {
+	n := value.Div(step).Round(0)
+	if n.LessThan(dec.NewFromInt(1)) {
+		n = dec.NewFromInt(1)
+	}
+	return n.Mul(step)
+}
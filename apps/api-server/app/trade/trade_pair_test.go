@@ -0,0 +1,49 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
+	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
+)
+
+func Test_ValidateTickAndStep(t *testing.T) {
+	tp := &tradegrpc.TradePair{
+		PriceTick:    &decimal.Decimal{Value: 1, Exp: -3},
+		QuantityStep: lo.ToPtr(int64(1000)),
+	}
+	tests := []struct {
+		name             string
+		price, quantity  string
+		expectedPrice    string
+		expectedQuantity string
+		valid            bool
+	}{
+		{"valid price and quantity", "0.25", "10000", "0.25", "10000", true},
+		{"market order (no price)", "0", "10000", "0", "10000", true},
+		{"price off tick", "0.2504", "10000", "0.25", "10000", false},
+		{"quantity off step", "0.25", "10600", "0.25", "11000", false},
+		{"quantity below step", "0.25", "100", "0.25", "1000", false},
+	}
+	for _, test := range tests {
+		price, quantity, valid := ValidateTickAndStep(tp, dec.RequireFromString(test.price), dec.RequireFromString(test.quantity))
+		if valid != test.valid {
+			t.Errorf("%s: valid is %t, expected %t", test.name, valid, test.valid)
+		}
+		if !price.Equal(dec.RequireFromString(test.expectedPrice)) {
+			t.Errorf("%s: price is %s, expected %s", test.name, price.String(), test.expectedPrice)
+		}
+		if !quantity.Equal(dec.RequireFromString(test.expectedQuantity)) {
+			t.Errorf("%s: quantity is %s, expected %s", test.name, quantity.String(), test.expectedQuantity)
+		}
+	}
+
+	// Trade pairs without known tick and step are not validated
+	_, _, valid := ValidateTickAndStep(&tradegrpc.TradePair{}, dec.RequireFromString("0.2504"), dec.RequireFromString("1"))
+	if !valid {
+		t.Errorf("expected trade pair without tick and step to be valid")
+	}
+}
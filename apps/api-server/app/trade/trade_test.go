@@ -9,10 +9,13 @@ import (
 	"testing"
 
 	currencyapp "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/currency"
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/currency"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/cursor"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -218,6 +221,88 @@ func Test_NormalizeTrade(t *testing.T) {
 	}
 }
 
+func Test_FillSummary(t *testing.T) {
+	fills := Trades{
+		{Trade: &tradegrpc.Trade{Amount: &decimal.Decimal{Value: 300, Exp: 0}}, HumanReadablePrice: "1"},
+		{Trade: &tradegrpc.Trade{Amount: &decimal.Decimal{Value: 100, Exp: 0}}, HumanReadablePrice: "2"},
+	}
+	averagePrice, filledPercentage := fillSummary(&decimal.Decimal{Value: 8, Exp: 2}, fills)
+	if averagePrice.String() != "1.25" {
+		t.Errorf("averagePrice is %s, expected 1.25", averagePrice.String())
+	}
+	if filledPercentage.String() != "50" {
+		t.Errorf("filledPercentage is %s, expected 50", filledPercentage.String())
+	}
+
+	averagePrice, filledPercentage = fillSummary(&decimal.Decimal{Value: 8, Exp: 2}, Trades{})
+	if !averagePrice.IsZero() || !filledPercentage.IsZero() {
+		t.Errorf("expected zero values for an order without fills, got %s and %s", averagePrice.String(), filledPercentage.String())
+	}
+}
+
+func Test_MergePage(t *testing.T) {
+	item := func(height, sequence int64, txid string) *dmn.Trade {
+		return &dmn.Trade{Trade: &tradegrpc.Trade{BlockHeight: height, Sequence: sequence, TXID: &txid}}
+	}
+	cancelledItem := func(height, sequence int64, txid string) *dmn.Trade {
+		tr := item(height, sequence, txid)
+		tr.Status = ordergrpc.OrderStatus_ORDER_STATUS_CANCELED
+		return tr
+	}
+	positions := func(page *TradesPage) []int64 {
+		res := make([]int64, 0, len(page.Trades))
+		for _, tr := range page.Trades {
+			res = append(res, tr.Sequence)
+		}
+		return res
+	}
+	trades := Trades{item(10, 5, "a"), item(8, 3, "b")}
+	cancelled := []*dmn.Trade{cancelledItem(11, 6, "e"), cancelledItem(9, 4, "c"), cancelledItem(7, 2, "d")}
+
+	// The cancelled orders count against the limit, the cursor is the last returned item
+	page := mergePage(append(Trades{}, trades...), cancelled, nil, false, false, 3)
+	if got := positions(page); len(got) != 3 || got[0] != 6 || got[1] != 5 || got[2] != 4 {
+		t.Errorf("Unexpected page %v", got)
+	}
+	if page.NextCursor == nil || *page.NextCursor != (&cursor.Cursor{BlockHeight: 9, Sequence: 4, TXID: "c", Cancelled: 4}).Encode() {
+		t.Fatalf("Unexpected cursor %v", page.NextCursor)
+	}
+
+	// The next page continues after the cursor, without repeating the cancelled orders
+	current, err := cursor.Decode(*page.NextCursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page = mergePage(Trades{item(8, 3, "b")}, cancelled[2:], current, false, false, 3)
+	if got := positions(page); len(got) != 2 || got[0] != 3 || got[1] != 2 || page.NextCursor != nil {
+		t.Errorf("Unexpected last page %v %v", got, page.NextCursor)
+	}
+
+	// With more trades, the cancelled orders after the last trade are left to the next page
+	page = mergePage(append(Trades{}, trades...), cancelled, nil, true, false, 0)
+	if got := positions(page); len(got) != 4 || got[3] != 3 {
+		t.Errorf("Unexpected page %v", got)
+	}
+	if page.NextCursor == nil || *page.NextCursor != (&cursor.Cursor{BlockHeight: 8, Sequence: 3, TXID: "b", Cancelled: 4}).Encode() {
+		t.Errorf("Unexpected cursor %v", page.NextCursor)
+	}
+
+	// With more cancelled orders, the trades after the last cancelled order are left to the next page
+	page = mergePage(append(Trades{}, trades...), cancelled[:2], nil, false, true, 0)
+	if got := positions(page); len(got) != 3 || got[2] != 4 {
+		t.Errorf("Unexpected page %v", got)
+	}
+	if page.NextCursor == nil || *page.NextCursor != (&cursor.Cursor{BlockHeight: 9, Sequence: 4, TXID: "c", Cancelled: 4}).Encode() {
+		t.Errorf("Unexpected cursor %v", page.NextCursor)
+	}
+	// A page without cancelled orders keeps the position of the cancelled orders
+	current = &cursor.Cursor{BlockHeight: 9, Sequence: 4, TXID: "c", Cancelled: 4}
+	page = mergePage(Trades{item(8, 3, "b")}, nil, current, true, false, 0)
+	if page.NextCursor == nil || *page.NextCursor != (&cursor.Cursor{BlockHeight: 8, Sequence: 3, TXID: "b", Cancelled: 4}).Encode() {
+		t.Errorf("Unexpected cursor %v", page.NextCursor)
+	}
+}
+
 func decCompare(a, b *decimal.Decimal) bool {
 	r := decimal.ToSDec(a)
 	s := decimal.ToSDec(b)
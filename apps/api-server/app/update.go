@@ -5,14 +5,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/samber/lo"
+	dec "github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/ticker"
 	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
@@ -22,16 +24,34 @@ import (
 	"github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	updateclient "github.com/CoreumFoundation/CoreDEX-API/domain/update/client"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 )
 
 const (
-	REFRESH_INTERVAL   = 1 * time.Second
-	WRITE_CHANNEL_SIZE = 25
-	OHLC_REFRESH       = 10 // Counter based on the REFRESH_INTERVAL in seconds (so 10 is 10 seconds)
-	WALLET_REFRESH     = 10 // Counter based on the REFRESH_INTERVAL in seconds (so 10 is 10 seconds)
+	REFRESH_INTERVAL       = 1 * time.Second
+	WRITE_CHANNEL_SIZE     = 25
+	WALLET_REFRESH         = 10 // Counter based on the REFRESH_INTERVAL in seconds (so 10 is 10 seconds)
+	RESYNC_REFRESH         = 30 // Full refresh of all channels, counter based on the REFRESH_INTERVAL in seconds
+	UPDATE_WINDOW          = 10 * time.Minute
+	CHANGES_BUFFER         = 100
+	CHANGES_RETRY_INTERVAL = 5 * time.Second
+	ELECTION_INTERVAL      = 5 * time.Second  // Campaign for (or extend) the leadership of the producer
+	ANNOUNCE_INTERVAL      = 10 * time.Second // Announce the subscriptions of the replica to the producer
+	WRITE_TIMEOUT          = 10 * time.Second
 )
 
+// Websocket subprotocols: Clients which negotiate PROTOBUF_SUBPROTOCOL receive binary messages (updateproto.Update)
+// with typed payloads, all other clients receive JSON messages (updateproto.Subscribe) with the content as JSON string.
+const (
+	PROTOBUF_SUBPROTOCOL = "protobuf"
+	JSON_SUBPROTOCOL     = "json"
+)
+
+// TICKER_REFRESH is the refresh of the tickers based on the cache duration TICKER_CACHE, counter based on the
+// REFRESH_INTERVAL
+const TICKER_REFRESH = int(ticker.TICKER_CACHE / REFRESH_INTERVAL)
+
 type Message struct {
 	Network metadata.Network
 	Method  updateproto.Method
@@ -39,312 +59,223 @@ type Message struct {
 	Content interface{}
 }
 
-type queueItem struct {
-	ws  *websocket.Conn
-	sub *updateproto.Subscription
-}
-
-type subscriptionManager struct {
-	*updateproto.Subscription
-	bufferChan chan queueItem
+// StartUpdater sends the changes of the subscribed content to the listeners of this replica.
+// The elected replica produces the updates from the changes published by the data-aggregator, all replicas fan them out
+// over the broker to their listeners. New listeners receive a snapshot of the channel, followed by the deltas.
+func (app *Application) StartUpdater(ctx context.Context) {
+	app.hub.run(ctx, app.receiveChanges)
 }
 
-var (
-	// Allow subscribing to the different types and methods for a certain websocket with potentially more than 1 key to listen for
-	// first map[string] contains the network identifier
-	// Map of network, method, websocket, id (id can be empty if it is a broadcast style message)
-	listeners         = make(map[*websocket.Conn][]*updateproto.Subscription)
-	senders           = make(map[*websocket.Conn]*subscriptionManager)
-	senderMutex       = sync.RWMutex{}
-	subscriptionMutex = sync.RWMutex{}
-)
-
-// StartUpdater Iterates over the listeners and sends the message to the listeners
-func (app *Application) StartUpdater(ctx context.Context) {
-	currentTime := time.Now()
-	refreshCounter := 0
+// receiveChanges streams the changes of all networks from the store, reconnecting when the stream fails
+func (app *Application) receiveChanges(ctx context.Context, events chan<- changesEvent) {
 	for {
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			refreshCounter++
-			uniqueSubscriptions := make(map[string]*updateproto.Subscription)
-			// Identify unique subscriptions:
-			subscriptionMutex.RLock()
-			for _, subscriptions := range listeners {
-				for _, subscription := range subscriptions {
-					uniqueSubscriptions[fmt.Sprintf("%s-%s-%s", subscription.Network, subscription.Method, subscription.ID)] = subscription
+		stream, err := app.updateClient.SubscribeChanges(updateclient.AuthCtx(ctx), &updateproto.ChangesFilter{})
+		if err == nil {
+			event := changesEvent{resync: true}
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case events <- event:
 				}
-			}
-			subscriptionMutex.RUnlock()
-			// Refresh the unique subscriptions:
-			// Synchronize the refresh interval at the modulus of the REFRESH_INTERVAL
-			// Calculate the start of the current interval
-			endOfInterval := currentTime.Truncate(REFRESH_INTERVAL)
-			if endOfInterval.Before(time.Now()) {
-				endOfInterval = time.Now().Truncate(REFRESH_INTERVAL)
-			}
-			startOfInterval := endOfInterval.Add(-REFRESH_INTERVAL)
-			// note for later:
-			// It is unknown how long all the refreshes will take. There can be 100s of subscriptions, leading to congestion.
-			// Using go routines and wait groups to refresh the subscriptions concurrently
-			// If this does not work out, we can delegate the unique subscriptions (for accounts) to the update process itself
-			wg := sync.WaitGroup{}
-			for _, subscription := range uniqueSubscriptions {
-				// Refresh the subscription:
-				switch subscription.Method {
-				case updateproto.Method_TRADES_FOR_ACCOUNT:
-					wg.Add(1)
-					soi := startOfInterval.Add(-10 * time.Minute)
-					go app.updateTradesForAccount(ctx, subscription, soi, endOfInterval, &wg)
-				case updateproto.Method_TRADES_FOR_SYMBOL:
-					wg.Add(1)
-					soi := startOfInterval.Add(-10 * time.Minute)
-					go app.updateTradesForSymbol(ctx, subscription, soi, endOfInterval, &wg)
-				case updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL:
-					wg.Add(1)
-					soi := startOfInterval.Add(-10 * time.Minute)
-					go app.updateTradesForAccountAndSymbol(ctx, subscription, soi, endOfInterval, &wg)
-				case updateproto.Method_TICKER:
-					// dynamic refresh interval based on the cache duration TICKER_CACHE
-					if refreshCounter%(int(ticker.TICKER_CACHE.Seconds())) == 0 {
-						wg.Add(1)
-						go app.updateTicker(ctx, subscription, &wg)
-					}
-				case updateproto.Method_OHLC:
-					// Reduced number of updates: Updating the OHLC to aggressive can lead to overload of FE, and to overload of the DB:
-					if refreshCounter%OHLC_REFRESH == 0 {
-						wg.Add(1)
-						/* From (startOfInterval, soi) needs to be corrected for the fact that the OHLC data (trade data) is delayed in the processing compared to the clock:
-						A block gets processed every 1.1 seconds, however can be fully for the previous bucket involved.
-						The from thus needs to include the previous bucket as well to be certain we have all the data.
-						Or more precise: The from needs to include the previous bucket if the current time is within the first 1.1 seconds of the current bucket,
-						add some margin for some delays: Using the refresh interval as the margin for the delay should be sufficient.
-						This can be done by setting the start of interval before the minute when we are in the first 5 seconds of the minute as indicated by soi
-						The underlying interval calculations will truncate the timestamp to the start of the actual interval involved (based on the period)
-
-						A further margin is added to counter a frontend issue where the websocket can sometimes disconnect when switching tabs (browser puts the tab to sleep).
-						While the FE should reconnect and refresh, sometimes the FE might not detect this. By returning just a bit longer period (10 minutes) the most visible issues
-						in the FE can be avoided
-						*/
-						soi := startOfInterval.Add(-10 * time.Minute)
-						go app.updateOHLC(ctx, subscription, soi, endOfInterval, &wg)
-					}
-				case updateproto.Method_ORDERBOOK:
-					wg.Add(1)
-					go app.updateOrderbook(ctx, subscription, &wg)
-				case updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT:
-					wg.Add(1)
-					go app.updateOrderbookForSymbolAndAccount(ctx, subscription, &wg)
-				case updateproto.Method_WALLET:
-					if refreshCounter%WALLET_REFRESH == 0 {
-						wg.Add(1)
-						go app.updateWallet(subscription, &wg)
-					}
+				changes, err := stream.Recv()
+				if err != nil {
+					logger.Warnf("Receiving the changes failed: %v", err)
+					break
 				}
+				event = changesEvent{changes: changes}
 			}
-			wg.Wait()
-			// Unique subscriptions are refreshed, and the content has been added/updated
-
-			// Send out the data to the listeners:
-			for ws, subscriptions := range listeners {
-				for _, subscription := range subscriptions {
-					if sub, ok := uniqueSubscriptions[fmt.Sprintf("%s-%s-%s", subscription.Network, subscription.Method, subscription.ID)]; ok {
-						app.addToQueue(ctx, ws, sub)
-					}
-				}
-			}
-			// Set start of next interval avoiding drift:
-			currentTime = endOfInterval.Add(REFRESH_INTERVAL)
-			// Sleep until the next interval:
-			if time.Until(currentTime) > 0 {
-				time.Sleep(time.Until(currentTime))
-			}
+		} else {
+			logger.Warnf("Subscribing to the changes failed: %v", err)
 		}
-	}
-}
-
-/* Self terminating pool of go routines to write to the websockets
-The self termination design here has been choosen to avoid having to cleanup go routines when the client disconnects
-The process is as follows:
-1. Check if there is a go routine for the socket in the senders map, if not:
-2. Create a channel for the socket in the senders map
-3. Start a go routine for the socket
-About the go routine:
-4. Go routine has a time out on which it terminates itself
-5. go routine removes itself from the list of senders
-
-Since we use the 3*REFRESH_INTERVAL as the time out for termination, the number of initializations and terminations is limited
-*/
-
-func (app *Application) addToQueue(ctx context.Context, ws *websocket.Conn, sub *updateproto.Subscription) {
-	senderMutex.Lock()
-	if _, ok := senders[ws]; !ok {
-		senders[ws] = &subscriptionManager{
-			Subscription: sub,
-			bufferChan:   make(chan queueItem, WRITE_CHANNEL_SIZE),
-		}
-		go app.startSender(ctx, ws)
-	}
-	if len(senders[ws].bufferChan) < WRITE_CHANNEL_SIZE {
-		senders[ws].bufferChan <- queueItem{ws, sub}
-	}
-	senderMutex.Unlock()
-}
-
-func (app *Application) startSender(ctx context.Context, ws *websocket.Conn) {
-	// Detach the channel from the sender map so that other manipulations can be done on the map (error handling can remove the socket from the map)
-	senderChan := senders[ws].bufferChan
-	for {
 		select {
 		case <-ctx.Done():
 			return
-		case writeRequest := <-senderChan:
-			app.writeMessage(writeRequest.ws, writeRequest.sub)
-		case <-time.After(3 * REFRESH_INTERVAL):
-			senderMutex.Lock()
-			close(senderChan)
-			delete(senders, ws)
-			senderMutex.Unlock()
-			return
+		case <-time.After(CHANGES_RETRY_INTERVAL):
 		}
 	}
 }
 
-func (app *Application) updateTradesForAccount(ctx context.Context, subscription *updateproto.Subscription, from, to time.Time, wg *sync.WaitGroup) {
-	trades, err := app.Trade.GetTrades(ctx, &tradegrpc.Filter{
-		Network: subscription.Network,
-		From:    timestamppb.New(from),
-		To:      timestamppb.New(to),
-		Account: &subscription.ID,
-	})
+// fetch retrieves the content of the channel.
+// Trades and order events are retrieved for the block of the changes, or for the window of the method for a full refresh.
+func (app *Application) fetch(ctx context.Context, subscription *updateproto.Subscription, changes *updateproto.Changes, now time.Time) (*channelRefresh, error) {
+	r := &channelRefresh{since: now.Add(-UPDATE_WINDOW).Unix()}
+	from, to := now.Add(-UPDATE_WINDOW), now.Add(REFRESH_INTERVAL)
+	var content interface{}
+	var err error
+	switch subscription.Method {
+	case updateproto.Method_TRADES_FOR_ACCOUNT,
+		updateproto.Method_TRADES_FOR_SYMBOL,
+		updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL:
+		if changes != nil && changes.BlockHeight > 0 && changes.BlockTime != nil {
+			// The store filters on the block time in seconds
+			from = changes.BlockTime.AsTime().Truncate(time.Second)
+			to = from.Add(time.Second)
+		}
+		r.items, err = app.tradeItems(ctx, subscription, from, to, now)
+		return r, err
+	case updateproto.Method_ORDERS_FOR_ACCOUNT:
+		if changes != nil && changes.BlockHeight > 0 && changes.BlockTime != nil {
+			from = changes.BlockTime.AsTime().Truncate(time.Second)
+			to = from.Add(time.Second)
+		}
+		r.items, err = app.orderEventItems(ctx, subscription, from, to)
+		return r, err
+	case updateproto.Method_OHLC:
+		r.items, r.since, err = app.ohlcItems(ctx, subscription, from, to)
+		return r, err
+	case updateproto.Method_TICKER:
+		opt := dmn.NewTickerReadOptions([]string{subscription.ID}, now.Truncate(time.Second), 24*time.Hour)
+		opt.Network = subscription.Network
+		content = app.Ticker.GetTickers(ctx, opt)
+	case updateproto.Method_ORDERBOOK:
+		var denoms *symbol.Symbol
+		denoms, err = symbol.NewSymbol(subscription.ID)
+		if err != nil {
+			return nil, err
+		}
+		content, err = app.Order.OrderBookRelevantOrders(subscription.Network, denoms.Denom1.Denom, denoms.Denom2.Denom, 50, true)
+	case updateproto.Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT:
+		// The account and denoms are concatenated with a separator _
+		account, sym := splitAccount(subscription.ID)
+		var denoms *symbol.Symbol
+		denoms, err = symbol.NewSymbol(sym)
+		if err != nil {
+			return nil, err
+		}
+		content, err = app.Order.OrderBookRelevantOrdersForAccount(subscription.Network, denoms.Denom1.Denom, denoms.Denom2.Denom, account)
+	case updateproto.Method_ORDERBOOKS_FOR_ACCOUNT:
+		content, err = app.Order.OrderBooksForAccount(subscription.Network, subscription.ID)
+	case updateproto.Method_DEPTH:
+		content, err = app.depth(ctx, subscription)
+	case updateproto.Method_WALLET:
+		content, err = app.Order.WalletAssets(subscription.Network, subscription.ID)
+	case updateproto.Method_TX_STATUS:
+		content, err = app.Order.TxStatus(ctx, subscription.Network, subscription.ID)
+	default:
+		return nil, fmt.Errorf("unsupported method %s", subscription.Method)
+	}
 	if err != nil {
-		logger.Errorf("Error getting trades: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	b, err := json.Marshal(trades)
+	b, err := json.Marshal(content)
 	if err != nil {
-		logger.Errorf("Error marshalling trades: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	subscription.Content = string(b)
-	wg.Done()
+	r.content = string(b)
+	return r, nil
 }
 
-func (app *Application) updateTradesForSymbol(ctx context.Context, subscription *updateproto.Subscription, from, to time.Time, wg *sync.WaitGroup) {
-	// The denoms are concatenated with a separator _
-	denoms, err := symbol.NewSymbol(subscription.ID)
-	if err != nil {
-		logger.Errorf("Error parsing denoms: %v", err)
-		wg.Done()
-		return
-	}
-	trades, err := app.Trade.GetTrades(ctx, &tradegrpc.Filter{
+// tradeItems retrieves the trades of the subscription, keyed by the TXID and sequence of the trade
+func (app *Application) tradeItems(ctx context.Context, subscription *updateproto.Subscription, from, to, now time.Time) ([]channelItem, error) {
+	filter := &tradegrpc.Filter{
 		Network: subscription.Network,
 		From:    timestamppb.New(from),
 		To:      timestamppb.New(to),
-		Denom1:  denoms.Denom1,
-		Denom2:  denoms.Denom2,
-		Side:    lo.ToPtr(orderproperties.Side_SIDE_BUY),
-	})
-	if err != nil {
-		logger.Errorf("Error getting trades: %v", err)
-		wg.Done()
-		return
-	}
-	b, err := json.Marshal(trades)
-	if err != nil {
-		logger.Errorf("Error marshalling trades: %v", err)
-		wg.Done()
-		return
 	}
-	subscription.Content = string(b)
-	wg.Done()
-}
-
-func (app *Application) updateTradesForAccountAndSymbol(ctx context.Context, subscription *updateproto.Subscription, from, to time.Time, wg *sync.WaitGroup) {
-	// The account and denoms are concatenated with a separator _
-	parts := strings.SplitN(subscription.ID, "_", 2)
-	if len(parts) != 2 {
-		logger.Errorf("Error parsing ID")
-		wg.Done()
-		return
+	switch subscription.Method {
+	case updateproto.Method_TRADES_FOR_ACCOUNT:
+		filter.Account = &subscription.ID
+	case updateproto.Method_TRADES_FOR_SYMBOL:
+		// The denoms are concatenated with a separator _
+		denoms, err := symbol.NewSymbol(subscription.ID)
+		if err != nil {
+			return nil, err
+		}
+		filter.Denom1 = denoms.Denom1
+		filter.Denom2 = denoms.Denom2
+		filter.Side = lo.ToPtr(orderproperties.Side_SIDE_BUY)
+	case updateproto.Method_TRADES_FOR_ACCOUNT_AND_SYMBOL:
+		// The account and denoms are concatenated with a separator _
+		account, sym := splitAccount(subscription.ID)
+		denoms, err := symbol.NewSymbol(sym)
+		if err != nil {
+			return nil, err
+		}
+		filter.Account = &account
+		filter.Denom1 = denoms.Denom1
+		filter.Denom2 = denoms.Denom2
 	}
-	account := parts[0]
-	denoms, err := symbol.NewSymbol(parts[1])
+	trades, err := app.Trade.GetTrades(ctx, filter)
 	if err != nil {
-		logger.Errorf("Error parsing denoms: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	trades, err := app.Trade.GetTrades(ctx, &tradegrpc.Filter{
-		Network: subscription.Network,
-		From:    timestamppb.New(from),
-		To:      timestamppb.New(to),
-		Account: &account,
-		Denom1:  denoms.Denom1,
-		Denom2:  denoms.Denom2,
-	})
-	if err != nil {
-		logger.Errorf("Error getting trades: %v", err)
-		wg.Done()
-		return
-	}
-	b, err := json.Marshal(trades)
-	if err != nil {
-		logger.Errorf("Error marshalling trades: %v", err)
-		wg.Done()
-		return
+	items := make([]channelItem, 0, len(*trades))
+	for _, trade := range *trades {
+		b, err := json.Marshal(trade)
+		if err != nil {
+			return nil, err
+		}
+		item := channelItem{content: b}
+		if trade.TXID != nil && trade.BlockTime != nil {
+			item.key = fmt.Sprintf("%s-%d", *trade.TXID, trade.Sequence)
+			item.time = trade.BlockTime.Seconds
+		} else {
+			// Cancelled orders have no transaction: Identified by their content and kept for the window
+			item.key = string(b)
+			item.time = now.Unix()
+		}
+		items = append(items, item)
 	}
-	subscription.Content = string(b)
-	wg.Done()
+	return items, nil
 }
 
-func (app *Application) updateTicker(ctx context.Context, subscription *updateproto.Subscription, wg *sync.WaitGroup) {
-	opt := dmn.NewTickerReadOptions([]string{subscription.ID}, time.Now().Truncate(time.Second), 24*time.Hour)
-	opt.Network = subscription.Network
-	tickers := app.Ticker.GetTickers(ctx, opt)
-	b, err := json.Marshal(tickers)
+// orderEventItems retrieves the order events of the subscription, keyed by the sequence of the order, the type of the
+// event and the remaining quantity (which distinguishes the partial fills)
+func (app *Application) orderEventItems(ctx context.Context, subscription *updateproto.Subscription, from, to time.Time) ([]channelItem, error) {
+	account, sym := splitAccount(subscription.ID)
+	var denoms *symbol.Symbol
+	if sym != "" {
+		var err error
+		denoms, err = symbol.NewSymbol(sym)
+		if err != nil {
+			return nil, err
+		}
+	}
+	events, err := app.Order.OrderEvents(ctx, subscription.Network, account, denoms, from, to)
 	if err != nil {
-		logger.Errorf("Error marshalling tickers: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	subscription.Content = string(b)
-	wg.Done()
+	items := make([]channelItem, 0, len(events))
+	for _, event := range events {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		item := channelItem{
+			key:     fmt.Sprintf("%d-%s-%s", event.Sequence, event.Type, event.RemainingSymbolAmount),
+			content: b,
+		}
+		if event.BlockTime != nil {
+			item.time = event.BlockTime.Seconds
+		}
+		items = append(items, item)
+	}
+	return items, nil
 }
 
-func (app *Application) updateOHLC(ctx context.Context, subscription *updateproto.Subscription, startOfInterval, endOfInterval time.Time, wg *sync.WaitGroup) {
+// ohlcItems retrieves the OHLC periods of the subscription, keyed by the start of the period.
+// Returns the start of the first period of the window.
+func (app *Application) ohlcItems(ctx context.Context, subscription *updateproto.Subscription, startOfInterval, endOfInterval time.Time) ([]channelItem, int64, error) {
 	// The denoms and the period (interval/bucket) are concatenated with a separator _ in the requesting ID (denom-issuer_denom2-issuer2_interval)
 	// where interval is the same as in the restful call to the ohlc endpoint
 	denomsPeriod := strings.Split(subscription.ID, "_")
 	if len(denomsPeriod) != 3 {
-		logger.Infof("Error parsing denoms and period (incorrect format): %v", denomsPeriod)
-		wg.Done()
-		return
+		return nil, 0, fmt.Errorf("incorrect format of denoms and period: %v", denomsPeriod)
 	}
-	// Parse into the correct format:
 	denom1, err := denom.NewDenom(denomsPeriod[0])
 	if err != nil {
-		logger.Errorf("Error parsing denom1: %v", err)
-		wg.Done()
-		return
+		return nil, 0, err
 	}
 	denom2, err := denom.NewDenom(denomsPeriod[1])
 	if err != nil {
-		logger.Errorf("Error parsing denom2: %v", err)
-		wg.Done()
-		return
+		return nil, 0, err
 	}
 	period, err := dmn.HttpPeriodToPeriod(denomsPeriod[2])
 	if err != nil {
-		logger.Errorf("Error parsing interval: %v", err)
-		wg.Done()
-		return
+		return nil, 0, err
 	}
+	// The underlying interval calculations truncate the timestamps to the start of the actual periods involved
 	from := period.ToOHLCKeyTimestampFrom(startOfInterval.UnixNano())
 	to := period.ToOHLCKeyTimestampTo(endOfInterval.UnixNano())
 	ohlcs, err := app.OHLC.Get(ctx, &ohlcgrpc.OHLCFilter{
@@ -355,165 +286,85 @@ func (app *Application) updateOHLC(ctx context.Context, subscription *updateprot
 		Network: subscription.Network,
 	})
 	if err != nil {
-		logger.Errorf("Error getting OHLCs: %v", err)
-		wg.Done()
-		return
-	}
-	b, err := json.Marshal(ohlcs)
-	if err != nil {
-		logger.Errorf("Error marshalling OHLCs: %v", err)
-		wg.Done()
-		return
+		return nil, 0, err
+	}
+	items := make([]channelItem, 0, len(ohlcs))
+	for _, ohlc := range ohlcs {
+		// The first element is the start of the period (seconds)
+		ts, ok := ohlc[0].(int64)
+		if !ok {
+			return nil, 0, fmt.Errorf("unexpected timestamp %v", ohlc[0])
+		}
+		b, err := json.Marshal(ohlc)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, channelItem{key: strconv.FormatInt(ts, 10), time: ts, content: b})
 	}
-	subscription.Content = string(b)
-	wg.Done()
+	return items, time.Unix(0, from).Unix(), nil
 }
 
-func (app *Application) updateOrderbook(_ context.Context, subscription *updateproto.Subscription, wg *sync.WaitGroup) {
-	// The account and denoms are concatenated with a separator _
-	denoms, err := symbol.NewSymbol(subscription.ID)
-	if err != nil {
-		logger.Errorf("Error parsing denoms: %v", err)
-		wg.Done()
-		return
+func (app *Application) depth(ctx context.Context, subscription *updateproto.Subscription) (*order.Depth, error) {
+	// The denoms and the grouping are concatenated with a separator _ (denom-issuer_denom2-issuer2_grouping)
+	parts := strings.Split(subscription.ID, "_")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("incorrect format of denoms and grouping: %v", parts)
 	}
-	orders, err := app.Order.OrderBookRelevantOrders(subscription.Network, denoms.Denom1.Denom, denoms.Denom2.Denom, 50, true)
+	denoms, err := symbol.NewSymbol(parts[0] + "_" + parts[1])
 	if err != nil {
-		logger.Errorf("Error getting orderbook orders: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	b, err := json.Marshal(orders)
+	grouping, err := dec.NewFromString(parts[2])
 	if err != nil {
-		logger.Errorf("Error marshalling orderbook orders: %v", err)
-		wg.Done()
-		return
+		return nil, err
 	}
-	subscription.Content = string(b)
-	wg.Done()
+	return app.Order.Depth(ctx, subscription.Network, denoms, grouping, order.DEPTH_LEVELS)
 }
 
-func (app *Application) updateOrderbookForSymbolAndAccount(_ context.Context, subscription *updateproto.Subscription, wg *sync.WaitGroup) {
-	// The account and denoms are concatenated with a separator _
-	parts := strings.SplitN(subscription.ID, "_", 2)
-	if len(parts) != 2 {
-		logger.Errorf("Error parsing ID")
-		wg.Done()
-		return
-	}
-	account := parts[0]
-	denoms, err := symbol.NewSymbol(parts[1])
-	if err != nil {
-		logger.Errorf("Error parsing denoms: %v", err)
-		wg.Done()
-		return
-	}
-	orders, err := app.Order.OrderBookRelevantOrdersForAccount(subscription.Network, denoms.Denom1.Denom, denoms.Denom2.Denom, account)
-	if err != nil {
-		logger.Errorf("Error getting orderbook orders: %v", err)
-		wg.Done()
-		return
-	}
-	b, err := json.Marshal(orders)
-	if err != nil {
-		logger.Errorf("Error marshalling orderbook orders: %v", err)
-		wg.Done()
-		return
-	}
-	subscription.Content = string(b)
-	wg.Done()
+func (app *Application) AddSocket(ws *websocket.Conn) {
+	app.hub.addSocket(ws)
 }
 
-func (app *Application) updateWallet(subscription *updateproto.Subscription, wg *sync.WaitGroup) {
-	wallet, err := app.Order.WalletAssets(subscription.Network, subscription.ID)
-	if err != nil {
-		logger.Errorf("Error getting wallet: %v", err)
-		wg.Done()
+// Subscribe the websocket connection for the given method and type, the snapshot of the channel is sent by the updater.
+// The subscription is acknowledged, or answered with an error when it is invalid or the websocket reached the limit
+// of subscriptions (0 is unlimited).
+func (app *Application) Subscribe(ws *websocket.Conn, update *updateproto.Subscribe, limit int) {
+	if err := validateSubscription(update.Subscription); err != nil {
+		app.SendError(ws, update.Subscription, err)
 		return
 	}
-	b, err := json.Marshal(wallet)
-	if err != nil {
-		logger.Errorf("Error marshalling wallet: %v", err)
-		wg.Done()
-		return
-	}
-	subscription.Content = string(b)
-	wg.Done()
-}
-
-func (*Application) AddSocket(ws *websocket.Conn) {
-	subscriptionMutex.RLock()
-	listeners[ws] = make([]*updateproto.Subscription, 0)
-	subscriptionMutex.RUnlock()
-}
-
-// Subscribe the websocket connection for the given method and type.
-// Note: The mutex.Unlock is specifically not using defer for performance reasons
-// (defer can take 10s to 100s of milliseconds in the go scheduler before it is executed)
-func (*Application) Subscribe(ws *websocket.Conn, update *updateproto.Subscribe) {
-	subscriptionMutex.Lock()
-	// Check if ws is present in the map:
-	if listeners[ws] == nil {
-		listeners[ws] = make([]*updateproto.Subscription, 0)
-	}
-	// Add the subscription to the map (Check if the subscription is already present):
-	for _, subscription := range listeners[ws] {
-		if subscription.Network == update.Subscription.Network &&
-			subscription.Method == update.Subscription.Method &&
-			subscription.ID == update.Subscription.ID {
-			subscriptionMutex.Unlock()
-			return
-		}
+	if err := app.hub.subscribe(ws, update.Subscription, limit); err != nil {
+		app.SendError(ws, update.Subscription, err)
 	}
-	listeners[ws] = append(listeners[ws], update.Subscription)
-	subscriptionMutex.Unlock()
 }
 
 // Unsubscribe the websocket connection for the given method and type.
-// Note: The mutex.Unlock is specifically not using defer for performance reasons
-// (defer can take 10s to 100s of milliseconds in the go scheduler before it is executed)
-func (*Application) Unsubscribe(ws *websocket.Conn, update *updateproto.Subscribe) {
-	subscriptionMutex.Lock()
-	// Check if ws is present in the map:
-	if listeners[ws] == nil {
-		subscriptionMutex.Unlock()
+func (app *Application) Unsubscribe(ws *websocket.Conn, update *updateproto.Subscribe) {
+	if update.Subscription == nil {
+		app.SendError(ws, nil, ErrNotSubscribed)
 		return
 	}
-	deleteSub := make([]*updateproto.Subscription, 0)
-	// Delete the subscription from the map:
-	for _, subscription := range listeners[ws] {
-		if subscription.Network == update.Subscription.Network &&
-			subscription.Method == update.Subscription.Method &&
-			subscription.ID == update.Subscription.ID {
-			deleteSub = append(deleteSub, subscription)
-		}
+	if err := app.hub.unsubscribe(ws, update.Subscription); err != nil {
+		app.SendError(ws, update.Subscription, err)
 	}
-	// Use the deleteSub array to remove the subscriptions from the listeners map
-	for _, subscription := range deleteSub {
-		for i, sub := range listeners[ws] {
-			if sub == subscription {
-				listeners[ws] = append(listeners[ws][:i], listeners[ws][i+1:]...)
-				break
-			}
-		}
+}
+
+// SendError answers a request of the websocket with an error frame
+func (app *Application) SendError(ws *websocket.Conn, subscription *updateproto.Subscription, err error) {
+	m := &updateproto.Subscribe{Action: updateproto.Action_ERROR, Error: err.Error()}
+	if subscription != nil {
+		m.Subscription = requestOf(subscription)
 	}
-	subscriptionMutex.Unlock()
+	app.hub.reply(ws, m)
 }
 
 // Close is called by both the client and by the sub processes trying to communicate with the client:
 // If the connection is gone, the sub process will call the close so that other sub processes don't have to try
 // and clean up the connection individually.
-func (*Application) Close(ws *websocket.Conn) {
-	subscriptionMutex.Lock()
-	// Check if ws is present in the map:
-	if listeners[ws] == nil {
-		subscriptionMutex.Unlock()
-		return
+func (app *Application) Close(ws *websocket.Conn) {
+	if app.hub.close(ws) {
+		ws.Close()
 	}
-	// Delete the subscription from the map:
-	delete(listeners, ws)
-	subscriptionMutex.Unlock()
-	ws.Close()
 }
 
 func (*Application) IsClosed(ws *websocket.Conn, wsErr error) bool {
@@ -526,6 +377,9 @@ func (*Application) IsClosed(ws *websocket.Conn, wsErr error) bool {
 	case errors.Is(wsErr, websocket.ErrCloseSent):
 		logger.Infof("Close send by websocket %s,%s: %s", ws.LocalAddr().String(), ws.RemoteAddr().String(), wsErr.Error())
 		return true
+	case websocket.IsCloseError(wsErr, websocket.CloseNormalClosure, websocket.CloseGoingAway):
+		logger.Infof("Websocket %s,%s closed by the client: %s", ws.LocalAddr().String(), ws.RemoteAddr().String(), wsErr.Error())
+		return true
 	default:
 		logger.Warnf("Unknown error writing to websocket %s,%s: %s", ws.LocalAddr().String(), ws.RemoteAddr().String(), wsErr.Error())
 		return true
@@ -533,19 +387,64 @@ func (*Application) IsClosed(ws *websocket.Conn, wsErr error) bool {
 
 }
 
-func (app *Application) writeMessage(ws *websocket.Conn, subscription *updateproto.Subscription) {
-	m := &updateproto.Subscribe{
-		Action: updateproto.Action_RESPONSE,
-		Subscription: &updateproto.Subscription{
-			Method:  subscription.Method,
-			ID:      subscription.ID,
-			Network: subscription.Network,
-			Content: subscription.Content,
-		},
-	}
-	if app.IsClosed(ws, ws.WriteJSON(m)) {
+// writeMessage writes the message of the hub to the websocket or the event stream
+func (app *Application) writeMessage(l listener, m *message) {
+	switch l := l.(type) {
+	case *websocket.Conn:
+		app.writeSocket(l, m)
+	case *eventStream:
+		if err := l.send(m.Subscribe); err != nil {
+			logger.Infof("Error writing to event stream %s: %v", l.remote, err)
+			l.Close()
+		}
+	}
+}
+
+func (app *Application) writeSocket(ws *websocket.Conn, m *message) {
+	// A client which does not read blocks the write: Fail instead of blocking the sender of the websocket
+	if err := ws.SetWriteDeadline(time.Now().Add(WRITE_TIMEOUT)); err != nil {
+		app.Close(ws)
+		return
+	}
+	var err error
+	if ws.Subprotocol() == PROTOBUF_SUBPROTOCOL {
+		err = writeBinary(ws, m)
+	} else {
+		err = ws.WriteJSON(m.Subscribe)
+	}
+	if app.IsClosed(ws, err) {
 		// The connection is dead, remove from the map
 		app.Close(ws)
 		return
 	}
 }
+
+// writeBinary writes the message with the typed payload as protobuf, encoded once for all listeners of the message
+func writeBinary(ws *websocket.Conn, m *message) error {
+	b, err := m.encoded()
+	if err != nil {
+		logger.Errorf("Error encoding the message for websocket %s: %v", ws.RemoteAddr().String(), err)
+		return nil
+	}
+	return ws.WriteMessage(websocket.BinaryMessage, b)
+}
+
+// disconnect closes the websocket or the event stream of a client which does not keep up with its messages
+func (app *Application) disconnect(l listener) {
+	switch l := l.(type) {
+	case *websocket.Conn:
+		app.disconnectSocket(l)
+	case *eventStream:
+		logger.Warnf("Event stream %s does not keep up with its messages, disconnecting", l.remote)
+		l.Close()
+	}
+}
+
+func (app *Application) disconnectSocket(ws *websocket.Conn) {
+	logger.Warnf("Websocket %s does not keep up with its messages, disconnecting", ws.RemoteAddr().String())
+	closeMessage := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "slow consumer")
+	if err := ws.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(WRITE_TIMEOUT)); err != nil {
+		logger.Infof("Error sending the close to websocket %s: %v", ws.RemoteAddr().String(), err)
+	}
+	app.Close(ws)
+}
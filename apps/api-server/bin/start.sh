@@ -20,8 +20,10 @@ export TRADE_STORE="localhost:50051"
 export OHLC_STORE="localhost:50051"
 export ORDER_STORE="localhost:50051"
 export CURRENCY_STORE="localhost:50051"
+export UPDATE_STORE="localhost:50051"
 export LOG_LEVEL="info"
 export HTTP_CONFIG="{\"port\": \":8080\",\"cors\": {\"allowedOrigins\":[\"http://localhost:3000\",\"http://localhost:3001\"]},\"timeouts\": {\"read\": \"10s\",\"write\": \"10s\",\"idle\": \"10s\",\"shutdown\": \"10s\"}}"
+export WS_CONFIG="{\"allowedOrigins\":[\"http://localhost:3000\",\"http://localhost:3001\"]}"
 export BASE_COIN="{\"BaseCoin\":[{\"Network\": \"mainnet\",\"Coin\": \"ucore\"},{\"Network\": \"testnet\",\"Coin\": \"utestcore\"},{\"Network\": \"devnet\",\"Coin\": \"udevcore\"}]}"
 export BASE_USDC="{\"BaseCoin\":[{\"Network\": \"mainnet\",\"Coin\": \"uusdc-E1E3674A0E4E1EF9C69646F9AF8D9497173821826074622D831BAB73CCB99A2D\"}]}"
 
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+)
+
+// Order is a historical order as stored by the data-aggregator with the precision of the denoms applied
+type Order struct {
+	*ordergrpc.Order
+	HumanReadablePrice    string
+	SymbolAmount          string
+	RemainingSymbolAmount string
+}
+
+// OrderDetail is a single order with the trades (fills) executed against it, oldest fill first
+type OrderDetail struct {
+	*Order
+	Fills            []*Trade
+	AverageFillPrice string // Average of the fill prices weighted by the filled amount
+	FilledPercentage string // Filled amount as percentage of the order quantity
+}
+
+// OrderEvent is a change in the lifecycle of an order with the precision of the denoms applied
+type OrderEvent struct {
+	*ordergrpc.OrderEvent
+	HumanReadablePrice    string
+	SymbolAmount          string
+	RemainingSymbolAmount string
+}
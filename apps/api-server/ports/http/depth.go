@@ -0,0 +1,55 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	dec "github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	dmnsymbol "github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// getDepth returns the order book grouped into price buckets with cumulative totals
+func (s *httpServer) getDepth() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		q := r.URL.Query()
+		sym, err := dmnsymbol.NewSymbol(q.Get("symbol"))
+		if err != nil {
+			return handler.NewAPIError(422, "symbol.invalid")
+		}
+		grouping := dec.Zero
+		if g := q.Get("grouping"); g != "" {
+			grouping, err = dec.NewFromString(g)
+			if err != nil || !grouping.IsPositive() {
+				return handler.NewAPIError(422, "grouping.invalid")
+			}
+		}
+		levels := order.DEPTH_LEVELS
+		if l := q.Get("levels"); l != "" {
+			levels, err = strconv.Atoi(l)
+			if err != nil || levels < 1 || levels > order.DEPTH_MAX_LEVELS {
+				return handler.NewAPIError(422, "levels.invalid")
+			}
+		}
+		depth, err := s.app.Order.Depth(r.Context(), network, sym, grouping, levels)
+		if errors.Is(err, order.ErrInvalidGrouping) {
+			return handler.NewAPIError(422, "grouping.invalid")
+		}
+		if err != nil {
+			logger.Errorf("Error getting depth for %s: %v", q.Get("symbol"), err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return json.NewEncoder(w).Encode(depth)
+	}
+}
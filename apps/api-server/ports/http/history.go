@@ -0,0 +1,95 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	dmnsymbol "github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// minSeriesInterval is the smallest interval of the order book series (the interval of the scheduled snapshots)
+const minSeriesInterval = 60
+
+// getOrderBookHistory returns the order book of a symbol at a point in time
+func (s *httpServer) getOrderBookHistory() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		q := r.URL.Query()
+		sym, err := dmnsymbol.NewSymbol(q.Get("symbol"))
+		if err != nil {
+			return handler.NewAPIError(422, "symbol.invalid")
+		}
+		at, err := unixTimeParam(q.Get("at"))
+		if err != nil {
+			return handler.NewAPIError(422, "at.invalid")
+		}
+		history, err := s.app.Order.OrderBookHistory(r.Context(), network, sym, at)
+		if errors.Is(err, order.ErrSnapshotNotFound) {
+			return handler.NewAPIError(404, "orderbook.not_found")
+		}
+		if err != nil {
+			logger.Errorf("Error getting order book history for %s at %d: %v", q.Get("symbol"), at.Unix(), err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return json.NewEncoder(w).Encode(history)
+	}
+}
+
+// getOrderBookSeries returns the best prices, spread and depth of the order book of a symbol per interval
+func (s *httpServer) getOrderBookSeries() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		q := r.URL.Query()
+		sym, err := dmnsymbol.NewSymbol(q.Get("symbol"))
+		if err != nil {
+			return handler.NewAPIError(422, "symbol.invalid")
+		}
+		from, err := unixTimeParam(q.Get("from"))
+		if err != nil {
+			return handler.NewAPIError(422, "from.invalid")
+		}
+		to, err := unixTimeParam(q.Get("to"))
+		if err != nil || !to.After(from) {
+			return handler.NewAPIError(422, "to.invalid")
+		}
+		interval, err := strconv.ParseInt(q.Get("interval"), 10, 64)
+		if err != nil || interval < minSeriesInterval {
+			return handler.NewAPIError(422, "interval.invalid")
+		}
+		if (to.Unix()-from.Unix())/interval > order.SERIES_MAX_POINTS {
+			return handler.NewAPIError(422, "interval.too_many")
+		}
+		series, err := s.app.Order.OrderBookSeries(r.Context(), network, sym, from, to, time.Duration(interval)*time.Second)
+		if err != nil {
+			logger.Errorf("Error getting order book series for %s: %v", q.Get("symbol"), err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return json.NewEncoder(w).Encode(series)
+	}
+}
+
+func unixTimeParam(s string) (time.Time, error) {
+	t, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if t < 0 || t > time.Now().Unix()+30 {
+		return time.Time{}, errors.New("time out of range")
+	}
+	return time.Unix(t, 0), nil
+}
@@ -0,0 +1,194 @@
+package http
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	dmncache "github.com/CoreumFoundation/CoreDEX-API/domain/cache"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// Responses are kept for retries within this period
+	idempotencyTTL = 24 * time.Hour
+	// Maximum length of the Idempotency-Key header
+	maxIdempotencyKeyLength = 255
+)
+
+// idempotentResponse is the stored response of a request with an Idempotency-Key.
+// A response which is not done yet belongs to a request which is still in progress.
+type idempotentResponse struct {
+	bodyHash    [sha256.Size]byte
+	done        bool
+	status      int
+	contentType string
+	body        []byte
+}
+
+// idempotencyScope returns the sender of the request (from the body), which scopes the Idempotency-Key: Clients which
+// use the same key do not receive each others responses. A request without sender is not deduplicated (the handler
+// rejects it).
+type idempotencyScope func(network metadata.Network, body []byte) (string, error)
+
+type idempotencyCache struct {
+	mutex *sync.RWMutex
+	data  map[string]*dmncache.LockableCache
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	c := &idempotencyCache{
+		mutex: &sync.RWMutex{},
+		data:  make(map[string]*dmncache.LockableCache),
+	}
+	go dmncache.CleanCache(c.data, c.mutex, idempotencyTTL)
+	return c
+}
+
+// responseRecorder captures the status and body written by a handler
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// idempotent makes a handler idempotent for requests with an Idempotency-Key header: A retry with the same key (for the
+// same network, path and sender) returns the original response instead of executing the request again.
+// Reusing a key with a different body is rejected, as is a retry while the original request is still in progress.
+// Server errors (5xx) are not stored, so the request can be retried with the same key.
+// The responses are kept in memory of the instance which handled the request: Retries routed to another replica are not
+// deduplicated (as the sequences of the accounts, the requests of an account need to reach the same replica).
+func (s *httpServer) idempotent(h handler.Handler, scope idempotencyScope) handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		idempotencyKey := r.Header.Get(idempotencyKeyHeader)
+		if idempotencyKey == "" {
+			return h(w, r)
+		}
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			return handler.NewAPIError(http.StatusBadRequest, "idempotency_key.invalid")
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		sender, err := scope(network, body)
+		if err != nil || sender == "" {
+			return h(w, r)
+		}
+		bodyHash := sha256.Sum256(body)
+		key := network.String() + "|" + r.URL.Path + "|" + sender + "|" + idempotencyKey
+
+		c := s.idempotency
+		c.mutex.Lock()
+		if cached, ok := c.data[key]; ok {
+			res := *cached.Value.(*idempotentResponse)
+			c.mutex.Unlock()
+			switch {
+			case res.bodyHash != bodyHash:
+				return handler.NewAPIError(http.StatusUnprocessableEntity, "idempotency_key.reused")
+			case !res.done:
+				return handler.NewAPIError(http.StatusConflict, "idempotency_key.in_progress")
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			if res.contentType != "" {
+				w.Header().Set("Content-Type", res.contentType)
+			}
+			w.WriteHeader(res.status)
+			_, err := w.Write(res.body)
+			return err
+		}
+		res := &idempotentResponse{bodyHash: bodyHash}
+		c.data[key] = &dmncache.LockableCache{LastUpdated: time.Now(), Value: res}
+		c.mutex.Unlock()
+
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		err = h(recorder, r)
+		if err != nil {
+			var apiErr *handler.APIError
+			if errors.As(err, &apiErr) && apiErr.Status < http.StatusInternalServerError {
+				// The error is written by the handler framework after returning, store it in the same format
+				b, marshalErr := json.Marshal(apiErr)
+				if marshalErr == nil {
+					s.storeIdempotentResponse(res, apiErr.Status, "application/json", append(b, '\n'))
+					return err
+				}
+			}
+			s.removeIdempotentResponse(key)
+			return err
+		}
+		if recorder.status >= http.StatusInternalServerError {
+			s.removeIdempotentResponse(key)
+			return nil
+		}
+		s.storeIdempotentResponse(res, recorder.status, w.Header().Get("Content-Type"), recorder.body.Bytes())
+		return nil
+	}
+}
+
+func (s *httpServer) storeIdempotentResponse(res *idempotentResponse, status int, contentType string, body []byte) {
+	s.idempotency.mutex.Lock()
+	res.status = status
+	res.contentType = contentType
+	res.body = body
+	res.done = true
+	s.idempotency.mutex.Unlock()
+}
+
+func (s *httpServer) removeIdempotentResponse(key string) {
+	s.idempotency.mutex.Lock()
+	delete(s.idempotency.data, key)
+	s.idempotency.mutex.Unlock()
+}
+
+// orderSender is the scope of /order/create: The sender of the order request
+func orderSender(_ metadata.Network, body []byte) (string, error) {
+	var orderReq MsgPlaceOrderRequest
+	if err := json.Unmarshal(body, &orderReq); err != nil {
+		return "", err
+	}
+	return orderReq.Sender, nil
+}
+
+// txSigners returns the scope of /order/submit: The signers of the transaction
+func (s *httpServer) txSigners(network metadata.Network, body []byte) (string, error) {
+	txData := &rawTxBody{}
+	if err := json.Unmarshal(body, txData); err != nil {
+		return "", err
+	}
+	rawTx, err := base64.StdEncoding.DecodeString(txData.TX)
+	if err != nil {
+		return "", err
+	}
+	signers, err := s.app.Order.TxSigners(network, rawTx)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(signers, ","), nil
+}
@@ -1,21 +1,37 @@
 package http
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/trade"
 	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	dmnsymbol "github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
@@ -25,8 +41,22 @@ type rawTxBody struct {
 	TX string
 }
 
+// SubmitResponse is the submitted transaction. A transaction which is not included in a block within the timeout is
+// returned (202) with the status pending and only the TXHash, to be tracked with GET /api/tx/{hash}.
 type SubmitResponse struct {
-	TXHash string
+	TXHash    string
+	Status    order.TxStatus
+	Height    int64
+	GasWanted int64
+	GasUsed   int64
+	Orders    []order.OrderSequence // The orders placed by the transaction with their DEX order sequence
+}
+
+// SubmitError is returned (422) when the chain rejects the transaction, either in the simulation or when broadcasting it.
+// The error name is stable (e.g. funds.insufficient, sequence.mismatch), the message is the log of the chain.
+type SubmitError struct {
+	Errors  []ErrorName `json:"errors"`
+	Message string
 }
 
 // GoodTil is a good til order settings.
@@ -48,11 +78,22 @@ type MsgPlaceOrderRequest struct {
 	Side        dextypes.Side
 	GoodTil     *GoodTil             `json:"goodTil,omitempty"`
 	TimeInForce dextypes.TimeInForce `json:"TimeInForce,omitempty"`
+	PubKey      string               `json:",omitempty"` // Optional, see SignDocsResult
+}
+
+// SignDocsResult is added to the create, cancel and replace responses. The PubKey (base64 encoded compressed secp256k1 key)
+// in the request is only required for the SIGN_MODE_DIRECT sign doc if the sender has not signed a transaction before.
+// If the sign docs can not be created (e.g. the simulation fails because of insufficient funds), SignDocError
+// contains the reason and the messages are still returned.
+type SignDocsResult struct {
+	SignDocs     *order.SignDocs `json:",omitempty"`
+	SignDocError string          `json:",omitempty"`
 }
 
 type OrderResponse struct {
 	Sequence  uint64
 	OrderData OrderData
+	SignDocsResult
 }
 
 type OrderData struct {
@@ -63,9 +104,65 @@ type OrderData struct {
 	GoodTil     *GoodTil             `json:"goodTil,omitempty"`
 }
 
+type ErrorName struct {
+	Name string `json:"name"`
+}
+
+// TickStepError is returned (422) when the price or quantity of an order do not match the price tick or quantity step of the market.
+// The nearest valid values are in the same notation as the request (price in subunits, quantity in base denom units).
+type TickStepError struct {
+	Errors          []ErrorName `json:"errors"`
+	PriceTick       string
+	QuantityStep    string
+	NearestPrice    string
+	NearestQuantity string
+}
+
 type OrderCancelResponse struct {
 	Sequence    uint64
 	OrderCancel dextypes.MsgCancelOrder
+	SignDocsResult
+}
+
+type MsgReplaceOrderRequest struct {
+	Sender   string
+	OrderID  string // The ID of the open order to be replaced
+	Price    string
+	Quantity string
+	PubKey   string `json:",omitempty"` // Optional, see SignDocsResult
+}
+
+type OrderReplaceResponse struct {
+	Sequence    uint64
+	OrderCancel dextypes.MsgCancelOrder
+	OrderData   OrderData
+	SignDocsResult
+}
+
+// Maximum number of orders in a single batch (all orders are placed in a single transaction)
+const maxBatchOrders = 50
+
+type MsgPlaceOrderBatchRequest struct {
+	Sender string
+	Orders []MsgPlaceOrderRequest // The Sender of the batch is used for all orders
+}
+
+// OrderBatchResult is the validation result of a single order in the batch
+type OrderBatchResult struct {
+	Valid     bool
+	OrderData *OrderData     `json:",omitempty"`
+	Error     string         `json:",omitempty"` // Name of the validation error
+	TickStep  *TickStepError `json:",omitempty"` // Nearest valid price and quantity if the price tick or quantity step is invalid
+}
+
+type OrderBatchResponse struct {
+	Sequence uint64
+	Orders   []OrderBatchResult
+}
+
+type OrdersCancelResponse struct {
+	Sequence     uint64
+	OrderCancels []dextypes.MsgCancelOrder
 }
 
 func (s *httpServer) createOrder() handler.Handler {
@@ -82,82 +179,256 @@ func (s *httpServer) createOrder() handler.Handler {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		price := decimal.NewFromInt(0)
-		if len(orderReq.Price) > 0 {
-			price, err = decimal.NewFromString(orderReq.Price)
-			if err != nil {
-				w.WriteHeader(http.StatusBadRequest)
-				return nil
-			}
+		o, buildErr := s.buildOrderData(r.Context(), network, &orderReq)
+		if buildErr != nil {
+			return buildErr.write(w)
 		}
-		var coreumPrice *dextypes.Price = nil
-		if !price.IsZero() {
-			parsedCoreumPrice, err := coreum.ParsePrice(price.String())
-			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-				return nil
-			}
-			coreumPrice = &parsedCoreumPrice
-		}
-		baseCurrency, err := s.app.Currency.GetCurrency(r.Context(), network, orderReq.BaseDenom)
+		sequence, err := s.app.Order.AccountSequence(network, orderReq.Sender)
 		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		baseDenomPrecision := int64(0)
-		if baseCurrency.Denom != nil && baseCurrency.Denom.Precision != nil {
-			baseDenomPrecision = int64(*baseCurrency.Denom.Precision)
-		}
+		return json.NewEncoder(w).Encode(OrderResponse{
+			Sequence:       sequence,
+			OrderData:      *o,
+			SignDocsResult: s.signDocs(r.Context(), network, orderReq.Sender, orderReq.PubKey, sequence, &o.MsgPlaceOrder),
+		})
+	}
+}
 
-		quantity, err := decimal.NewFromString(orderReq.Quantity)
-		if err != nil {
+// signDocs builds the sign docs for the msgs. Failures are reported in the response instead of failing the request.
+func (s *httpServer) signDocs(ctx context.Context, network metadata.Network, sender, pubKey string, sequence uint64, msgs ...sdk.Msg) SignDocsResult {
+	signDocs, err := s.app.Order.SignDocs(ctx, network, sender, pubKey, sequence, msgs...)
+	if err != nil {
+		logger.Warnf("Error creating sign docs for %s: %v", sender, err)
+		return SignDocsResult{SignDocError: err.Error()}
+	}
+	return SignDocsResult{SignDocs: signDocs}
+}
+
+// createOrderBatch validates multiple orders (across markets) which are placed in a single transaction.
+// The results are in the order of the request. If any of the orders is invalid a 422 is returned with the results,
+// so that the batch is either placed completely or not at all.
+func (s *httpServer) createOrderBatch() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		defer r.Body.Close()
+		var batchReq MsgPlaceOrderBatchRequest
+		err := json.NewDecoder(r.Body).Decode(&batchReq)
+		if err != nil || batchReq.Sender == "" || len(batchReq.Orders) == 0 {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		quantity = quantity.Mul(decimal.New(1, int32(baseDenomPrecision)))
-		if quantity.Rat().Denom().Cmp(math.OneInt().BigInt()) != 0 {
-			// entered quantity is outside the precision range
+		if len(batchReq.Orders) > maxBatchOrders {
+			return handler.NewAPIError(422, "orders.too_many")
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		// Generate a UUID for the ID:
-		orderReq.OrderID = uuid.New().String()
-		msgPlaceOrder := dextypes.MsgPlaceOrder{
-			Sender:      orderReq.Sender,
-			Type:        orderReq.Type,
-			ID:          orderReq.OrderID,
-			BaseDenom:   orderReq.BaseDenom,
-			QuoteDenom:  orderReq.QuoteDenom,
-			Price:       coreumPrice,
-			Quantity:    math.NewIntFromBigInt(quantity.Rat().Num()),
-			Side:        orderReq.Side,
-			TimeInForce: orderReq.TimeInForce,
-		}
-		if orderReq.GoodTil != nil {
-			msgPlaceOrder.GoodTil = &dextypes.GoodTil{
-				GoodTilBlockHeight: orderReq.GoodTil.GoodTilBlockHeight,
-				GoodTilBlockTime:   orderReq.GoodTil.GoodTilBlockTime,
+		res := OrderBatchResponse{
+			Orders: make([]OrderBatchResult, 0, len(batchReq.Orders)),
+		}
+		valid := true
+		orderIDs := make(map[string]bool)
+		for i := range batchReq.Orders {
+			orderReq := &batchReq.Orders[i]
+			orderReq.Sender = batchReq.Sender
+			// Client order IDs have to be unique within the batch as well
+			if orderReq.OrderID != "" && orderIDs[orderReq.OrderID] {
+				valid = false
+				res.Orders = append(res.Orders, OrderBatchResult{Error: "order_id.duplicate"})
+				continue
 			}
+			orderIDs[orderReq.OrderID] = true
+			o, buildErr := s.buildOrderData(r.Context(), network, orderReq)
+			if buildErr != nil {
+				valid = false
+				res.Orders = append(res.Orders, OrderBatchResult{
+					Error:    buildErr.Name,
+					TickStep: buildErr.TickStep,
+				})
+				continue
+			}
+			res.Orders = append(res.Orders, OrderBatchResult{
+				Valid:     true,
+				OrderData: o,
+			})
 		}
-		sequence, err := s.app.Order.AccountSequence(network, orderReq.Sender)
+		if !valid {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return json.NewEncoder(w).Encode(res)
+		}
+		res.Sequence, err = s.app.Order.AccountSequence(network, batchReq.Sender)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		o := OrderData{
-			MsgPlaceOrder: msgPlaceOrder,
-			BaseDenom:     orderReq.BaseDenom,
-			QuoteDenom:    orderReq.QuoteDenom,
-			TimeInForce:   orderReq.TimeInForce,
+		return json.NewEncoder(w).Encode(res)
+	}
+}
+
+// orderBuildError is the reason an order request could not be turned into a MsgPlaceOrder
+type orderBuildError struct {
+	Status   int
+	Name     string
+	TickStep *TickStepError
+}
+
+// write the error in the format of createOrder: The tick and step errors have the nearest valid values in the body,
+// the other errors only the error name
+func (e *orderBuildError) write(w http.ResponseWriter) error {
+	w.WriteHeader(e.Status)
+	if e.TickStep != nil {
+		return json.NewEncoder(w).Encode(e.TickStep)
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Errors []ErrorName `json:"errors"`
+	}{[]ErrorName{{Name: e.Name}}})
+}
+
+// orderIDRegex is the order ID format accepted by the DEX module
+var orderIDRegex = regexp.MustCompile(`^[a-zA-Z0-9/+:._-]{1,40}$`)
+
+// validateOrderID validates a client supplied order ID against the rules of the chain, and checks that the sender did
+// not use the ID before (the chain rejects IDs which are in use by an open order).
+func (s *httpServer) validateOrderID(ctx context.Context, network metadata.Network, sender, orderID string) *orderBuildError {
+	if !orderIDRegex.MatchString(orderID) {
+		return &orderBuildError{Status: http.StatusUnprocessableEntity, Name: "order_id.invalid"}
+	}
+	exists, err := s.app.Order.OrderIDExists(ctx, network, sender, orderID)
+	if err != nil {
+		logger.Errorf("Error checking order id %s for %s: %v", orderID, sender, err)
+		return &orderBuildError{Status: http.StatusInternalServerError, Name: "server.internal_error"}
+	}
+	if exists {
+		return &orderBuildError{Status: http.StatusConflict, Name: "order_id.duplicate"}
+	}
+	return nil
+}
+
+// buildOrderData validates the order request and translates it into the MsgPlaceOrder.
+// The order ID of the request is used if provided (client order ID), otherwise an ID is generated.
+// The quantity is corrected for the precision of the base denom and validated against the quantity step and price tick of the market.
+func (s *httpServer) buildOrderData(ctx context.Context, network metadata.Network, orderReq *MsgPlaceOrderRequest) (*OrderData, *orderBuildError) {
+	var err error
+	price := decimal.NewFromInt(0)
+	if len(orderReq.Price) > 0 {
+		price, err = decimal.NewFromString(orderReq.Price)
+		if err != nil {
+			return nil, &orderBuildError{Status: http.StatusBadRequest, Name: "price.invalid"}
 		}
-		if orderReq.GoodTil != nil {
-			o.GoodTil = orderReq.GoodTil
+	}
+	var coreumPrice *dextypes.Price = nil
+	if !price.IsZero() {
+		parsedCoreumPrice, err := coreum.ParsePrice(price.String())
+		if err != nil {
+			return nil, &orderBuildError{Status: http.StatusInternalServerError, Name: "price.invalid"}
 		}
-		return json.NewEncoder(w).Encode(OrderResponse{
-			Sequence:  sequence,
-			OrderData: o,
-		})
+		coreumPrice = &parsedCoreumPrice
+	}
+	baseCurrency, err := s.app.Currency.GetCurrency(ctx, network, orderReq.BaseDenom)
+	if err != nil {
+		return nil, &orderBuildError{Status: http.StatusInternalServerError, Name: "currency.unknown"}
+	}
+	baseDenomPrecision := int64(0)
+	if baseCurrency.Denom != nil && baseCurrency.Denom.Precision != nil {
+		baseDenomPrecision = int64(*baseCurrency.Denom.Precision)
+	}
+
+	quantity, err := decimal.NewFromString(orderReq.Quantity)
+	if err != nil {
+		return nil, &orderBuildError{Status: http.StatusBadRequest, Name: "quantity.invalid"}
+	}
+	quantity = quantity.Mul(decimal.New(1, int32(baseDenomPrecision)))
+	if quantity.Rat().Denom().Cmp(math.OneInt().BigInt()) != 0 {
+		// entered quantity is outside the precision range
+		return nil, &orderBuildError{Status: http.StatusBadRequest, Name: "quantity.precision.invalid"}
 	}
+	// Validate the price tick and quantity step (if known) to prevent submitting a transaction the chain will reject:
+	baseDenom, err := denom.NewDenom(orderReq.BaseDenom)
+	if err != nil {
+		return nil, &orderBuildError{Status: http.StatusBadRequest, Name: "denom.invalid"}
+	}
+	quoteDenom, err := denom.NewDenom(orderReq.QuoteDenom)
+	if err != nil {
+		return nil, &orderBuildError{Status: http.StatusBadRequest, Name: "denom.invalid"}
+	}
+	// A market without trade pair has no known price tick and quantity step, any other failure of the lookup rejects
+	// the order instead of skipping the validation
+	market, err := s.app.Trade.GetMarket(ctx, &dmnsymbol.Symbol{Denom1: baseDenom, Denom2: quoteDenom}, network)
+	switch {
+	case errors.Is(err, trade.ErrMarketNotFound):
+	case err != nil:
+		logger.Errorf("Error retrieving the market %s_%s: %v", orderReq.BaseDenom, orderReq.QuoteDenom, err)
+		return nil, &orderBuildError{Status: http.StatusServiceUnavailable, Name: "market.unavailable"}
+	default:
+		if tickStepErr := validateTickAndStep(market, price, quantity, int32(baseDenomPrecision)); tickStepErr != nil {
+			return nil, &orderBuildError{Status: http.StatusUnprocessableEntity, Name: tickStepErr.Errors[0].Name, TickStep: tickStepErr}
+		}
+	}
+	if orderReq.OrderID != "" {
+		if idErr := s.validateOrderID(ctx, network, orderReq.Sender, orderReq.OrderID); idErr != nil {
+			return nil, idErr
+		}
+	} else {
+		// Generate a UUID for the ID:
+		orderReq.OrderID = uuid.New().String()
+	}
+	msgPlaceOrder := dextypes.MsgPlaceOrder{
+		Sender:      orderReq.Sender,
+		Type:        orderReq.Type,
+		ID:          orderReq.OrderID,
+		BaseDenom:   orderReq.BaseDenom,
+		QuoteDenom:  orderReq.QuoteDenom,
+		Price:       coreumPrice,
+		Quantity:    math.NewIntFromBigInt(quantity.Rat().Num()),
+		Side:        orderReq.Side,
+		TimeInForce: orderReq.TimeInForce,
+	}
+	if orderReq.GoodTil != nil {
+		msgPlaceOrder.GoodTil = &dextypes.GoodTil{
+			GoodTilBlockHeight: orderReq.GoodTil.GoodTilBlockHeight,
+			GoodTilBlockTime:   orderReq.GoodTil.GoodTilBlockTime,
+		}
+	}
+	o := &OrderData{
+		MsgPlaceOrder: msgPlaceOrder,
+		BaseDenom:     orderReq.BaseDenom,
+		QuoteDenom:    orderReq.QuoteDenom,
+		TimeInForce:   orderReq.TimeInForce,
+	}
+	if orderReq.GoodTil != nil {
+		o.GoodTil = orderReq.GoodTil
+	}
+	return o, nil
+}
+
+// validateTickAndStep returns a TickStepError if the price or quantity (in subunits) are not valid for the market
+func validateTickAndStep(market *tradegrpc.TradePair, price, quantity decimal.Decimal, baseDenomPrecision int32) *TickStepError {
+	nearestPrice, nearestQuantity, ok := trade.ValidateTickAndStep(market, price, quantity)
+	if ok {
+		return nil
+	}
+	precision := decimal.New(1, baseDenomPrecision)
+	res := &TickStepError{
+		Errors:          make([]ErrorName, 0),
+		NearestPrice:    nearestPrice.String(),
+		NearestQuantity: nearestQuantity.Div(precision).String(),
+	}
+	if !nearestPrice.Equal(price) {
+		res.Errors = append(res.Errors, ErrorName{Name: "price.tick.invalid"})
+	}
+	if !nearestQuantity.Equal(quantity) {
+		res.Errors = append(res.Errors, ErrorName{Name: "quantity.step.invalid"})
+	}
+	if market.PriceTick != nil {
+		res.PriceTick = decimal.New(market.PriceTick.Value, market.PriceTick.Exp).String()
+	}
+	if market.QuantityStep != nil {
+		res.QuantityStep = decimal.NewFromInt(*market.QuantityStep).Div(precision).String()
+	}
+	return res
 }
 
 func (s *httpServer) cancelOrder() handler.Handler {
@@ -166,6 +437,7 @@ func (s *httpServer) cancelOrder() handler.Handler {
 		var orderReq struct {
 			Sender  string
 			OrderID string
+			PubKey  string // Optional, see SignDocsResult
 		}
 		err := json.NewDecoder(r.Body).Decode(&orderReq)
 		if err != nil {
@@ -187,8 +459,128 @@ func (s *httpServer) cancelOrder() handler.Handler {
 			ID:     orderReq.OrderID,
 		}
 		return json.NewEncoder(w).Encode(OrderCancelResponse{
-			Sequence:    sequence,
-			OrderCancel: msgCancelOrder,
+			Sequence:       sequence,
+			OrderCancel:    msgCancelOrder,
+			SignDocsResult: s.signDocs(r.Context(), network, orderReq.Sender, orderReq.PubKey, sequence, &msgCancelOrder),
+		})
+	}
+}
+
+// cancelAllOrders cancels all open orders of the sender, or only the open orders in one market if a symbol is provided.
+// The cancels are returned as individual MsgCancelOrder messages to be signed and submitted in a single transaction
+// (MsgCancelOrdersByDenom can only be used by the admin of the denom).
+func (s *httpServer) cancelAllOrders() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		defer r.Body.Close()
+		var orderReq struct {
+			Sender string
+			Symbol string
+		}
+		err := json.NewDecoder(r.Body).Decode(&orderReq)
+		if err != nil || orderReq.Sender == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		var denom1, denom2 string
+		if orderReq.Symbol != "" {
+			symbol, err := dmnsymbol.NewSymbol(orderReq.Symbol)
+			if err != nil {
+				return handler.NewAPIError(422, "symbol.invalid")
+			}
+			denom1 = symbol.Denom1.Denom
+			denom2 = symbol.Denom2.Denom
+		}
+		orders, err := s.app.Order.OpenOrders(network, orderReq.Sender, denom1, denom2)
+		if err != nil {
+			logger.Errorf("Error retrieving open orders for %s: %v", orderReq.Sender, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		sequence, err := s.app.Order.AccountSequence(network, orderReq.Sender)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		msgCancelOrders := make([]dextypes.MsgCancelOrder, 0, len(orders))
+		for _, o := range orders {
+			msgCancelOrders = append(msgCancelOrders, dextypes.MsgCancelOrder{
+				Sender: orderReq.Sender,
+				ID:     o.ID,
+			})
+		}
+		return json.NewEncoder(w).Encode(OrdersCancelResponse{
+			Sequence:     sequence,
+			OrderCancels: msgCancelOrders,
+		})
+	}
+}
+
+// replaceOrder cancels an open order and places a new order with the new price and quantity in a single transaction.
+// All other properties of the new order (market, side, type, time in force and good til) are taken from the open order.
+// The messages need to be included in the transaction in the returned order (cancel first), so that the funds locked
+// by the open order are released before the new order is placed.
+func (s *httpServer) replaceOrder() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		defer r.Body.Close()
+		var replaceReq MsgReplaceOrderRequest
+		err := json.NewDecoder(r.Body).Decode(&replaceReq)
+		// Only limit orders can be open, so the replacement always requires a price
+		if err != nil || replaceReq.Sender == "" || replaceReq.OrderID == "" || replaceReq.Price == "" || replaceReq.Quantity == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		openOrder, err := s.app.Order.OpenOrder(r.Context(), network, replaceReq.Sender, replaceReq.OrderID)
+		if err != nil {
+			if order.OrderNotFound(err) {
+				return handler.NewAPIError(404, "order.not_found")
+			}
+			logger.Errorf("Error retrieving open order %s of %s: %v", replaceReq.OrderID, replaceReq.Sender, err)
+			return err
+		}
+		orderReq := MsgPlaceOrderRequest{
+			Sender:      replaceReq.Sender,
+			Type:        openOrder.Type,
+			BaseDenom:   openOrder.BaseDenom,
+			QuoteDenom:  openOrder.QuoteDenom,
+			Price:       replaceReq.Price,
+			Quantity:    replaceReq.Quantity,
+			Side:        openOrder.Side,
+			TimeInForce: openOrder.TimeInForce,
+		}
+		if openOrder.GoodTil != nil {
+			orderReq.GoodTil = &GoodTil{
+				GoodTilBlockHeight: openOrder.GoodTil.GoodTilBlockHeight,
+				GoodTilBlockTime:   openOrder.GoodTil.GoodTilBlockTime,
+			}
+		}
+		o, buildErr := s.buildOrderData(r.Context(), network, &orderReq)
+		if buildErr != nil {
+			return buildErr.write(w)
+		}
+		sequence, err := s.app.Order.AccountSequence(network, replaceReq.Sender)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		msgCancelOrder := dextypes.MsgCancelOrder{
+			Sender: replaceReq.Sender,
+			ID:     replaceReq.OrderID,
+		}
+		return json.NewEncoder(w).Encode(OrderReplaceResponse{
+			Sequence:       sequence,
+			OrderCancel:    msgCancelOrder,
+			OrderData:      *o,
+			SignDocsResult: s.signDocs(r.Context(), network, replaceReq.Sender, replaceReq.PubKey, sequence, &msgCancelOrder, &o.MsgPlaceOrder),
 		})
 	}
 }
@@ -217,14 +609,31 @@ func (s *httpServer) submitOrder() handler.Handler {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-		res, err := s.app.Order.SubmitTx(network, rawTx)
+		res, err := s.app.Order.SubmitTx(r.Context(), network, rawTx)
 		if err != nil {
+			var chainErr *order.ChainError
+			if errors.As(err, &chainErr) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return json.NewEncoder(w).Encode(SubmitError{
+					Errors:  []ErrorName{{Name: chainErr.Name}},
+					Message: chainErr.Message,
+				})
+			}
 			logger.Errorf("Error submitting tx: %v", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return nil
 		}
 		submitResponse := SubmitResponse{
-			TXHash: res.TxHash,
+			TXHash:    res.TxResponse.TxHash,
+			Status:    order.TxStatusIncluded,
+			Height:    res.TxResponse.Height,
+			GasWanted: res.TxResponse.GasWanted,
+			GasUsed:   res.TxResponse.GasUsed,
+			Orders:    res.PlacedOrders,
+		}
+		if res.Pending {
+			submitResponse.Status = order.TxStatusPending
+			w.WriteHeader(http.StatusAccepted)
 		}
 		return json.NewEncoder(w).Encode(submitResponse)
 	}
@@ -262,3 +671,104 @@ func (s *httpServer) getOrders() handler.Handler {
 		return json.NewEncoder(w).Encode(res)
 	}
 }
+
+// getAccountOrders returns the open orders of the account over all markets, by symbol
+func (s *httpServer) getAccountOrders() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		account := r.URL.Query().Get("account")
+		if account == "" {
+			return handler.NewAPIError(http.StatusBadRequest, "account.invalid")
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		res, err := s.app.Order.OrderBooksForAccount(network, account)
+		if err != nil {
+			logger.Errorf("Error retrieving the orders of account %s: %v", account, err)
+			return err
+		}
+		return json.NewEncoder(w).Encode(res)
+	}
+}
+
+func (s *httpServer) getOrderHistory() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		opt, err := validateOrderParams(r.URL.Query())
+		if err != nil {
+			return err
+		}
+		opt.Network = network
+		page, err := s.app.Order.GetOrdersPage(r.Context(), opt)
+		if err != nil {
+			logger.Errorf("Error retrieving the order history: %v", err)
+			return err
+		}
+		return json.NewEncoder(w).Encode(page)
+	}
+}
+
+// getOrder returns a single order with its fills
+func (s *httpServer) getOrder() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		sequence, err := strconv.ParseInt(mux.Vars(r)["sequence"], 10, 64)
+		if err != nil || sequence <= 0 {
+			return handler.NewAPIError(422, "sequence.invalid")
+		}
+		o, err := s.app.Order.GetOrder(r.Context(), network, sequence)
+		if status.Code(err) == codes.NotFound {
+			return handler.NewAPIError(404, "order.not_found")
+		}
+		if err != nil {
+			logger.Errorf("Error retrieving order %d: %v", sequence, err)
+			return err
+		}
+		detail, err := s.app.Trade.GetOrderDetail(r.Context(), o)
+		if err != nil {
+			logger.Errorf("Error retrieving fills for order %d: %v", sequence, err)
+			return err
+		}
+		return json.NewEncoder(w).Encode(detail)
+	}
+}
+
+// validateOrderParams validates the parameters for the order history endpoint and translates them into an order filter.
+// The status is provided as a name (open, filled, canceled, expired) so that the caller does not need to know the enum values.
+// The order history is always paged, with defaultPageLimit orders if no limit is requested.
+func validateOrderParams(query url.Values) (*ordergrpc.Filter, error) {
+	params, err := validateHistoryParams(query, "account_or_symbol.missing")
+	if err != nil {
+		return nil, err
+	}
+	of := &ordergrpc.Filter{
+		Account: params.account,
+		Denom1:  params.denom1,
+		Denom2:  params.denom2,
+		From:    params.from,
+		To:      params.to,
+		Side:    params.side,
+		Cursor:  params.cursor,
+		Limit:   params.limit,
+	}
+	if of.Limit == nil {
+		of.Limit = lo.ToPtr(int32(defaultPageLimit))
+	}
+	status := query.Get("status")
+	if status != "" {
+		orderStatus, ok := ordergrpc.OrderStatus_value["ORDER_STATUS_"+strings.ToUpper(status)]
+		if !ok || orderStatus == int32(ordergrpc.OrderStatus_ORDER_STATUS_UNSPECIFIED) {
+			return nil, handler.NewAPIError(422, "status.invalid")
+		}
+		of.OrderStatus = lo.ToPtr(ordergrpc.OrderStatus(orderStatus))
+	}
+	return of, nil
+}
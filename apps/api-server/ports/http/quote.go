@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	dec "github.com/shopspring/decimal"
+
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
+	dmnsymbol "github.com/CoreumFoundation/CoreDEX-API/domain/symbol"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// getQuote estimates the execution of a market order against the current order book
+func (s *httpServer) getQuote() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		q := r.URL.Query()
+		sym, err := dmnsymbol.NewSymbol(q.Get("symbol"))
+		if err != nil {
+			return handler.NewAPIError(422, "symbol.invalid")
+		}
+		sideInt, err := strconv.Atoi(q.Get("side"))
+		side := orderproperties.Side(sideInt)
+		if err != nil || (side != orderproperties.Side_SIDE_BUY && side != orderproperties.Side_SIDE_SELL) {
+			return handler.NewAPIError(422, "side.invalid")
+		}
+		quantity, err := dec.NewFromString(q.Get("quantity"))
+		if err != nil || !quantity.IsPositive() {
+			return handler.NewAPIError(422, "quantity.invalid")
+		}
+		var limitPrice *dec.Decimal
+		if lp := q.Get("limit_price"); lp != "" {
+			p, err := dec.NewFromString(lp)
+			if err != nil || !p.IsPositive() {
+				return handler.NewAPIError(422, "limit_price.invalid")
+			}
+			limitPrice = &p
+		}
+		quote, err := s.app.Order.Quote(r.Context(), network, sym, side, quantity, limitPrice)
+		if err != nil {
+			logger.Errorf("Error calculating quote for %s: %v", q.Get("symbol"), err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return json.NewEncoder(w).Encode(quote)
+	}
+}
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/shopspring/decimal"
+
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app/order"
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+type RouteRequest struct {
+	Sender string
+	From   string // Denom to convert
+	To     string // Denom to receive
+	Amount string // Amount of From to convert (human readable, e.g. 1.5)
+	PubKey string `json:",omitempty"` // Optional, see SignDocsResult
+}
+
+type RouteResponse struct {
+	Sequence             uint64
+	Route                *order.Route
+	ExpectedSymbolOutput string // ExpectedOutput of the route with the precision of To applied
+	SignDocsResult
+}
+
+// routeOrder returns the best route to convert an amount of one denom into another over one or more markets, with the
+// unsigned transaction (IOC orders) to execute it
+func (s *httpServer) routeOrder() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		defer r.Body.Close()
+		var routeReq RouteRequest
+		err := json.NewDecoder(r.Body).Decode(&routeReq)
+		if err != nil || routeReq.Sender == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		if routeReq.From == "" || routeReq.To == "" || routeReq.From == routeReq.To {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "denom.invalid")
+		}
+		fromCurrency, err := s.app.Currency.GetCurrency(r.Context(), network, routeReq.From)
+		if err != nil {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "currency.unknown")
+		}
+		toCurrency, err := s.app.Currency.GetCurrency(r.Context(), network, routeReq.To)
+		if err != nil {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "currency.unknown")
+		}
+		amount, err := decimal.NewFromString(routeReq.Amount)
+		if err != nil || !amount.IsPositive() {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "amount.invalid")
+		}
+		amount = amount.Mul(decimal.New(1, fromCurrency.Denom.GetPrecision()))
+		if !amount.IsInteger() {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "amount.precision.invalid")
+		}
+		route, err := s.app.Order.BestRoute(r.Context(), network, routeReq.Sender, routeReq.From, routeReq.To, math.NewIntFromBigInt(amount.BigInt()))
+		if errors.Is(err, order.ErrNoRoute) {
+			return handler.NewAPIError(http.StatusUnprocessableEntity, "route.not_found")
+		}
+		if err != nil {
+			logger.Errorf("Error finding route from %s to %s: %v", routeReq.From, routeReq.To, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		sequence, err := s.app.Order.AccountSequence(network, routeReq.Sender)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return nil
+		}
+		msgs := make([]sdk.Msg, 0, len(route.Hops))
+		for i := range route.Hops {
+			msgs = append(msgs, &route.Hops[i].Order)
+		}
+		expectedOutput, _ := decimal.NewFromString(route.ExpectedOutput)
+		return json.NewEncoder(w).Encode(RouteResponse{
+			Sequence:             sequence,
+			Route:                route,
+			ExpectedSymbolOutput: expectedOutput.Div(decimal.New(1, toCurrency.Denom.GetPrecision())).String(),
+			SignDocsResult:       s.signDocs(r.Context(), network, routeReq.Sender, routeReq.PubKey, sequence, msgs...),
+		})
+	}
+}
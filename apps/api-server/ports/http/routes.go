@@ -3,6 +3,8 @@ package http
 import (
 	"net/http"
 
+	"github.com/gorilla/websocket"
+
 	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app"
 	behttp "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/http"
 	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
@@ -12,12 +14,16 @@ import (
 const routePrepend = "/api"
 
 type httpServer struct {
-	app *app.Application
+	app         *app.Application
+	idempotency *idempotencyCache
+	ws          *wsConfig
+	upgrader    *websocket.Upgrader
 }
 
 // NewHttpServer sets up the routes and returns a startable http server.
 func NewHttpServer(app *app.Application) *behttp.Server {
-	s := httpServer{app: app}
+	ws := parseWsConfig()
+	s := httpServer{app: app, idempotency: newIdempotencyCache(), ws: ws, upgrader: newUpgrader(ws)}
 	behttp.InitHealth(behttp.Route{
 		Path: routePrepend + "/healthz", Method: behttp.GET, Handler: s.Health(),
 	})
@@ -27,12 +33,26 @@ func NewHttpServer(app *app.Application) *behttp.Server {
 		{Path: routePrepend + "/trades", Method: behttp.GET, Handler: s.getTrades()},
 		{Path: routePrepend + "/currencies", Method: behttp.GET, Handler: s.getCurrencies()},
 		{Path: routePrepend + "/market", Method: behttp.GET, Handler: s.getMarket()},
-		{Path: routePrepend + "/order/create", Method: behttp.POST, Handler: s.createOrder()},
+		{Path: routePrepend + "/quote", Method: behttp.GET, Handler: s.getQuote()},
+		{Path: routePrepend + "/order/create", Method: behttp.POST, Handler: s.idempotent(s.createOrder(), orderSender)},
+		{Path: routePrepend + "/order/create-batch", Method: behttp.POST, Handler: s.createOrderBatch()},
 		{Path: routePrepend + "/order/cancel", Method: behttp.POST, Handler: s.cancelOrder()},
-		{Path: routePrepend + "/order/submit", Method: behttp.POST, Handler: s.submitOrder()},
+		{Path: routePrepend + "/order/cancel-all", Method: behttp.POST, Handler: s.cancelAllOrders()},
+		{Path: routePrepend + "/order/replace", Method: behttp.POST, Handler: s.replaceOrder()},
+		{Path: routePrepend + "/order/route", Method: behttp.POST, Handler: s.routeOrder()},
+		{Path: routePrepend + "/order/submit", Method: behttp.POST, Handler: s.idempotent(s.submitOrder(), s.txSigners)},
 		{Path: routePrepend + "/order/orderbook", Method: behttp.GET, Handler: s.getOrders()},
+		{Path: routePrepend + "/order/orderbooks", Method: behttp.GET, Handler: s.getAccountOrders()},
+		{Path: routePrepend + "/order/depth", Method: behttp.GET, Handler: s.getDepth()},
+		{Path: routePrepend + "/orderbook/history", Method: behttp.GET, Handler: s.getOrderBookHistory()},
+		{Path: routePrepend + "/orderbook/history/series", Method: behttp.GET, Handler: s.getOrderBookSeries()},
+		{Path: routePrepend + "/order/{sequence:[0-9]+}", Method: behttp.GET, Handler: s.getOrder()},
+		{Path: routePrepend + "/orders", Method: behttp.GET, Handler: s.getOrderHistory()},
+		{Path: routePrepend + "/tx/{hash:(?:0x)?[0-9a-fA-F]{64}}", Method: behttp.GET, Handler: s.getTx()},
 		{Path: routePrepend + "/wallet/assets", Method: behttp.GET, Handler: s.getAssets()},
+		{Path: routePrepend + "/wallet", Method: behttp.GET, Handler: s.getWallet()},
 		{Path: routePrepend + "/ws", Method: behttp.GET, Handler: s.wsEndpoint()},
+		{Path: routePrepend + "/stream", Method: behttp.GET, Handler: s.streamEndpoint()},
 	})
 	return behttp.HTTPServer
 }
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+)
+
+// streamEndpoint streams the channel of the subscription (query parameters network, method and id) as Server-Sent
+// Events, an alternative to the websocket for clients behind proxies which block the upgrade.
+// The stream resumes with the Last-Event-ID header (set by EventSource on a reconnect) or the lastEventId parameter.
+func (s *httpServer) streamEndpoint() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		q := r.URL.Query()
+		// EventSource can not set headers: The network is a query parameter, the header is accepted as well
+		network, err := networklib.Network(r)
+		if n := q.Get("network"); n != "" {
+			v, ok := metadata.Network_value[strings.ToUpper(n)]
+			network, err = metadata.Network(v), nil
+			if !ok {
+				err = networklib.ErrInvalidNetwork
+			}
+		}
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		method, ok := updateproto.Method_value[strings.ToUpper(q.Get("method"))]
+		if !ok {
+			return handler.NewAPIError(422, "method.invalid")
+		}
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = q.Get("lastEventId")
+		}
+		subscription := &updateproto.Subscription{
+			Network: network,
+			Method:  updateproto.Method(method),
+			ID:      q.Get("id"),
+		}
+		// Blocks until the client disconnects, the heartbeats follow the pings of the websockets. The subscription is
+		// validated before anything is written.
+		if err := s.app.Stream(r.Context(), w, r.RemoteAddr, subscription, lastEventID, s.ws.PingInterval.Duration); err != nil {
+			return handler.NewAPIError(422, "subscription.invalid")
+		}
+		return nil
+	}
+}
@@ -12,11 +12,20 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/cursor"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
 	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
 	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const (
+	// Maximum number of records returned in a single page
+	maxPageLimit = 1000
+	// Number of records returned in a page if no limit is requested
+	defaultPageLimit = 50
 )
 
 type TradeOptionsFromParams struct {
@@ -47,32 +56,70 @@ func (s *httpServer) getTrades() handler.Handler {
 			return handler.NewAPIError(422, err.Error())
 		}
 		opt.Network = network
-		retvals, err := s.app.Trade.GetTrades(r.Context(), opt)
+		page, err := s.app.Trade.GetTradesPage(r.Context(), opt)
 		if err != nil {
+			// A paging client would take an empty page without next_cursor for the last page
+			if isPaged(opt.Cursor, opt.Limit) {
+				logger.Errorf("Error retrieving the trades: %v", err)
+				return err
+			}
 			return json.NewEncoder(w).Encode(&tradegrpc.Trades{})
 		}
-		return json.NewEncoder(w).Encode(retvals)
+		if isPaged(opt.Cursor, opt.Limit) {
+			return json.NewEncoder(w).Encode(page)
+		}
+		return json.NewEncoder(w).Encode(page.Trades)
 	}
 }
 
-// validateTradeParams validates the parameters for the trade endpoint.
-// Returns a correct query, period and the original from timestamp.
-// This originalFrom timestamp is used to prevent confusing the FE graph which does not seem to be able to handle anything different than what is outputs.
+// validateTradeParams validates the parameters for the trade endpoint and translates them into a trade filter.
 func validateTradeParams(query url.Values) (*tradegrpc.Filter, error) {
-	// Translate the from to the period start from:
+	params, err := validateHistoryParams(query, "external_id.invalid")
+	if err != nil {
+		return nil, err
+	}
+	// Limit interval to 24hrs max to prevent overflows (in all reasonable scenarios)
+	if params.from != nil && params.to != nil && params.to.AsTime().Sub(params.from.AsTime()) > 24*time.Hour {
+		return nil, handler.NewAPIError(422, "interval.too.long")
+	}
+	return &tradegrpc.Filter{
+		Account: params.account,
+		Denom1:  params.denom1,
+		Denom2:  params.denom2,
+		From:    params.from,
+		To:      params.to,
+		Side:    params.side,
+		Cursor:  params.cursor,
+		Limit:   params.limit,
+	}, nil
+}
+
+// historyParams are the parameters shared by the trade and the order history endpoints
+type historyParams struct {
+	account        *string
+	denom1, denom2 *denom.Denom
+	from, to       *timestamppb.Timestamp
+	side           *orderproperties.Side
+	cursor         *string
+	limit          *int32
+}
+
+// validateHistoryParams validates the parameters shared by the trade and the order history endpoints.
+// An account or a symbol is required, missing is the name of the error when neither is provided.
+func validateHistoryParams(query url.Values, missing string) (*historyParams, error) {
 	symbol := query.Get("symbol")
 	account := query.Get("account")
 	if account == "" && symbol == "" {
-		return nil, handler.NewAPIError(422, "external_id.invalid")
+		return nil, handler.NewAPIError(422, missing)
 	}
-	tf := &tradegrpc.Filter{}
+	params := &historyParams{}
 	from := query.Get("from")
 	if from != "" {
 		fr, err := strconv.ParseInt(from, 10, 64)
 		if err != nil {
 			return nil, handler.NewAPIError(422, "from.invalid")
 		}
-		tf.From = timestamppb.New(time.Unix(fr, 0))
+		params.from = timestamppb.New(time.Unix(fr, 0))
 	}
 	to := query.Get("to")
 	if to != "" {
@@ -80,15 +127,11 @@ func validateTradeParams(query url.Values) (*tradegrpc.Filter, error) {
 		if err != nil {
 			return nil, handler.NewAPIError(422, "to.invalid")
 		}
-		tf.To = timestamppb.New(time.Unix(t, 0))
+		params.to = timestamppb.New(time.Unix(t, 0))
 	}
-	if tf.From != nil && tf.From.AsTime().After(tf.To.AsTime()) {
+	if params.from != nil && params.to != nil && params.from.AsTime().After(params.to.AsTime()) {
 		return nil, handler.NewAPIError(422, "from.after.to")
 	}
-	// Limit interval to 24hrs max to prevent overflows (in all reasonable scenarios)
-	if tf.From != nil && tf.To != nil && tf.To.AsTime().Sub(tf.From.AsTime()) > 24*time.Hour {
-		return nil, handler.NewAPIError(422, "interval.too.long")
-	}
 	side := query.Get("side")
 	if side != "" {
 		// Parse side into a valid trade side:
@@ -97,13 +140,13 @@ func validateTradeParams(query url.Values) (*tradegrpc.Filter, error) {
 			return nil, handler.NewAPIError(422, "side.invalid")
 		}
 		// Parse into orderproperties.Side:
-		tf.Side = lo.ToPtr(orderproperties.Side(sideInt))
-		if *tf.Side == orderproperties.Side_SIDE_UNSPECIFIED {
+		params.side = lo.ToPtr(orderproperties.Side(sideInt))
+		if _, ok := orderproperties.Side_name[int32(*params.side)]; !ok || *params.side == orderproperties.Side_SIDE_UNSPECIFIED {
 			return nil, handler.NewAPIError(422, "side.invalid")
 		}
 	}
 	if account != "" {
-		tf.Account = &account
+		params.account = &account
 	}
 	if symbol != "" {
 		// Parse the symbol to see if it is valid:
@@ -119,8 +162,40 @@ func validateTradeParams(query url.Values) (*tradegrpc.Filter, error) {
 		if err != nil {
 			return nil, handler.NewAPIError(422, "symbol.invalid")
 		}
-		tf.Denom1 = b
-		tf.Denom2 = q
+		params.denom1 = b
+		params.denom2 = q
+	}
+	c, limit, err := validatePageParams(query)
+	if err != nil {
+		return nil, err
 	}
-	return tf, nil
+	params.cursor = c
+	params.limit = limit
+	return params, nil
+}
+
+// validatePageParams validates the optional cursor and limit parameters used for paging through trades and orders.
+// The cursor is opaque to the caller: It is the next_cursor value returned by the previous page.
+func validatePageParams(query url.Values) (*string, *int32, error) {
+	var c *string
+	var limit *int32
+	if cur := query.Get("cursor"); cur != "" {
+		if _, err := cursor.Decode(cur); err != nil {
+			return nil, nil, handler.NewAPIError(422, "cursor.invalid")
+		}
+		c = &cur
+	}
+	if l := query.Get("limit"); l != "" {
+		li, err := strconv.ParseInt(l, 10, 32)
+		if err != nil || li <= 0 || li > maxPageLimit {
+			return nil, nil, handler.NewAPIError(422, "limit.invalid")
+		}
+		limit = lo.ToPtr(int32(li))
+	}
+	return c, limit, nil
+}
+
+// isPaged returns true if the caller requested a paged response (which includes the next_cursor)
+func isPaged(c *string, limit *int32) bool {
+	return c != nil || limit != nil
 }
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	networklib "github.com/CoreumFoundation/CoreDEX-API/domain/network"
+	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+// getTx returns the status of a submitted transaction with the DEX orders it placed or closed
+func (s *httpServer) getTx() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		network, err := networklib.Network(r)
+		if err != nil {
+			return handler.NewAPIError(401, "network.invalid")
+		}
+		hash := mux.Vars(r)["hash"]
+		tx, err := s.app.Order.TxStatus(r.Context(), network, hash)
+		if err != nil {
+			logger.Errorf("Error retrieving tx %s: %v", hash, err)
+			return err
+		}
+		return json.NewEncoder(w).Encode(tx)
+	}
+}
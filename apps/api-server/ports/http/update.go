@@ -2,65 +2,124 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/CoreumFoundation/CoreDEX-API/apps/api-server/app"
 	updateproto "github.com/CoreumFoundation/CoreDEX-API/domain/update"
 	handler "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/httphandler"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 )
 
-var upgrader = websocket.Upgrader{
-	ReadBufferSize:  1024,
-	WriteBufferSize: 4096,
-	CheckOrigin:     func(r *http.Request) bool { return true },
+func newUpgrader(cfg *wsConfig) *websocket.Upgrader {
+	return &websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 4096,
+		CheckOrigin:     cfg.checkOrigin,
+		// protobuf is preferred when the client requests both subprotocols, JSON without subprotocol
+		Subprotocols:      []string{app.PROTOBUF_SUBPROTOCOL, app.JSON_SUBPROTOCOL},
+		EnableCompression: cfg.Compression,
+	}
 }
 
 func (s *httpServer) wsEndpoint() handler.Handler {
 	return func(w http.ResponseWriter, r *http.Request) error {
 		// upgrade this connection to a WebSocket connection
-		ws, err := upgrader.Upgrade(w, r, nil)
+		ws, err := s.upgrader.Upgrade(w, r, nil)
 		if err != nil {
 			logger.Warnf("Error upgrading connection to websocket %+v: %+v", ws, err)
 			return err
 		}
 		s.app.AddSocket(ws)
+		// Remove the subscriptions when the connection ends (closed, idle or failed)
+		defer s.app.Close(ws)
 		err = ws.WriteMessage(1, []byte("Connected"))
 		if err != nil {
 			logger.Warnf("Error acknowledging websocket connection %+v: %+v", ws, err)
 			return err
 		}
+		ws.SetReadLimit(s.ws.MaxMessageSize)
+		// Every message of the client (including the pongs) extends the connection
+		idle := func() error { return ws.SetReadDeadline(time.Now().Add(s.ws.IdleTimeout.Duration)) }
+		if err := idle(); err != nil {
+			return err
+		}
+		ws.SetPongHandler(func(string) error { return idle() })
+		done := make(chan struct{})
+		defer close(done)
+		go s.pinger(ws, done)
 		// listen indefinitely for new messages coming through on the WebSocket
-		s.reader(ws)
+		s.reader(ws, idle)
 		return nil
 	}
 }
 
-func (s *httpServer) reader(ws *websocket.Conn) {
+// pinger pings the client until done, the pongs keep the connection alive
+func (s *httpServer) pinger(ws *websocket.Conn, done chan struct{}) {
+	ticker := time.NewTicker(s.ws.PingInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			// WriteControl can be used concurrently with the writes of the updater
+			if err := ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(s.ws.PingInterval.Duration)); err != nil {
+				logger.Infof("Error pinging websocket %s: %v", ws.RemoteAddr().String(), err)
+				return
+			}
+		}
+	}
+}
+
+func (s *httpServer) reader(ws *websocket.Conn, idle func() error) {
 	for {
 		// read a message
-		_, p, err := ws.ReadMessage()
+		messageType, p, err := ws.ReadMessage()
 		if err != nil {
+			// IsClosed logs the reason of the close
 			if s.app.IsClosed(ws, err) {
-				logger.Warnf("reader: Invalid message. Socket is closing? ws: %s, %s: %+v", ws.LocalAddr().String(), ws.RemoteAddr().String(), err)
+				logger.Debugf("reader: Socket is closing. ws: %s, %s: %+v", ws.LocalAddr().String(), ws.RemoteAddr().String(), err)
+			} else {
+				logger.Warnf("reader: Error reading message. ws: %s, %s: %+v", ws.LocalAddr().String(), ws.RemoteAddr().String(), err)
 			}
-			logger.Warnf("reader: Error reading message. ws: %s, %s: %+v", ws.LocalAddr().String(), ws.RemoteAddr().String(), err)
 			return
 		}
+		if err := idle(); err != nil {
+			return
+		}
+		// Requests are JSON, or protobuf (updateproto.Subscribe) in binary frames
 		m := &updateproto.Subscribe{}
-		err = json.Unmarshal(p, m)
-		if err != nil {
-			logger.Warnf("reader: invalid json %v: %v", p, err)
-			continue
+		if messageType == websocket.BinaryMessage {
+			err = proto.Unmarshal(p, m)
+			if err != nil {
+				logger.Warnf("reader: invalid protobuf message: %v", err)
+				s.app.SendError(ws, nil, fmt.Errorf("invalid protobuf message: %v", err))
+				continue
+			}
+		} else {
+			err = json.Unmarshal(p, m)
+			if err != nil {
+				logger.Warnf("reader: invalid json %v: %v", p, err)
+				s.app.SendError(ws, nil, fmt.Errorf("invalid json: %v", err))
+				continue
+			}
 		}
 		switch m.Action {
 		case updateproto.Action_SUBSCRIBE:
-			s.app.Subscribe(ws, m)
+			s.app.Subscribe(ws, m, s.ws.MaxSubscriptions)
 		case updateproto.Action_UNSUBSCRIBE:
 			s.app.Unsubscribe(ws, m)
 		case updateproto.Action_CLOSE:
+			// The connection is closed: Stop reading, which ends the handler
 			s.app.Close(ws)
+			return
+		default:
+			s.app.SendError(ws, m.Subscription, fmt.Errorf("unsupported action %s", m.Action))
 		}
 	}
 }
@@ -25,3 +25,22 @@ func (s *httpServer) getAssets() handler.Handler {
 		return json.NewEncoder(w).Encode(res)
 	}
 }
+
+// getWallet returns the assets of a wallet with the total USD value
+func (s *httpServer) getWallet() handler.Handler {
+	return func(w http.ResponseWriter, r *http.Request) error {
+		defer r.Body.Close()
+		address := r.URL.Query().Get("address")
+		network, err := networklib.Network(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return err
+		}
+		res, err := s.app.Order.Wallet(network, address)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return nil
+		}
+		return json.NewEncoder(w).Encode(res)
+	}
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	behttp "github.com/CoreumFoundation/CoreDEX-API/utils/httplib/http"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const wsConfigEnv = "WS_CONFIG"
+
+// wsConfig configures the websocket connections, optional: Without WS_CONFIG the defaults apply.
+// {"allowedOrigins":["http://localhost:3000"],"maxSubscriptions":100,"pingInterval":"30s","idleTimeout":"60s","compression":true}
+type wsConfig struct {
+	// Origins allowed to connect, all origins are allowed if empty
+	AllowedOrigins []string `json:"allowedOrigins"`
+	// Maximum number of subscriptions per connection, 0 is unlimited
+	MaxSubscriptions int `json:"maxSubscriptions"`
+	// Interval of the pings to the client
+	PingInterval behttp.Duration `json:"pingInterval"`
+	// A connection without any message (including the pongs) for this duration is closed
+	IdleTimeout behttp.Duration `json:"idleTimeout"`
+	// Maximum size of a message from the client in bytes
+	MaxMessageSize int64 `json:"maxMessageSize"`
+	// Compress the messages (permessage-deflate) for the clients which support it
+	Compression bool `json:"compression"`
+}
+
+func defaultWsConfig() *wsConfig {
+	return &wsConfig{
+		MaxSubscriptions: 100,
+		PingInterval:     behttp.Duration{Duration: 30 * time.Second},
+		IdleTimeout:      behttp.Duration{Duration: 60 * time.Second},
+		MaxMessageSize:   4096,
+		Compression:      true,
+	}
+}
+
+// parseWsConfig parses WS_CONFIG over the defaults. The application exits with a fatal for an invalid configuration.
+func parseWsConfig() *wsConfig {
+	cfg := defaultWsConfig()
+	e := os.Getenv(wsConfigEnv)
+	if e != "" {
+		if err := json.Unmarshal([]byte(e), cfg); err != nil {
+			logger.Fatalf("Could not parse %s: %v", wsConfigEnv, err)
+		}
+	}
+	if cfg.PingInterval.Duration <= 0 || cfg.IdleTimeout.Duration <= cfg.PingInterval.Duration {
+		logger.Fatalf("%s: pingInterval has to be positive and less than idleTimeout", wsConfigEnv)
+	}
+	if len(cfg.AllowedOrigins) == 0 {
+		logger.Warnf("%s has no allowedOrigins, websocket connections are accepted from all origins", wsConfigEnv)
+	}
+	return cfg
+}
+
+// checkOrigin allows requests without origin (not from a browser) and from the allowed origins
+func (c *wsConfig) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || len(c.AllowedOrigins) == 0 {
+		return true
+	}
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	logger.Warnf("Websocket connection from origin %s rejected", origin)
+	return false
+}
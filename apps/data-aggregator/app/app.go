@@ -10,7 +10,9 @@ import (
 	currencyapp "github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/currency"
 	marketapp "github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/market"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/ohlc"
+	orderbookapp "github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/orderbook"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/state"
+	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/update"
 	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/domain"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/domain/dex"
 	"github.com/CoreumFoundation/CoreDEX-API/coreum"
@@ -19,8 +21,10 @@ import (
 	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	orderclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	stateclient "github.com/CoreumFoundation/CoreDEX-API/domain/state/client"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	tradeclient "github.com/CoreumFoundation/CoreDEX-API/domain/trade/client"
+	updateclient "github.com/CoreumFoundation/CoreDEX-API/domain/update/client"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
 )
@@ -32,6 +36,7 @@ type Application struct {
 	orderClient    order.OrderServiceClient
 	tradeClient    tradegrpc.TradeServiceClient
 	currencyClient currency.CurrencyServiceClient
+	publisher      *update.Publisher
 }
 
 type App interface {
@@ -42,7 +47,9 @@ func NewApplication(ctx context.Context) *Application {
 	orderClient := orderclient.Client()
 	tradeClient := tradeclient.Client()
 	currencyClient := currencyclient.Client()
-	return NewApplicationWithClients(ctx, orderClient, tradeClient, currencyClient)
+	app := NewApplicationWithClients(ctx, orderClient, tradeClient, currencyClient)
+	app.publisher = update.NewPublisher(updateclient.Client())
+	return app
 }
 
 func NewApplicationWithClients(
@@ -83,12 +90,12 @@ func (l *Application) StartScanners(ctx context.Context) {
 			marketApp := marketapp.NewApplication(reader, l.tradeClient)
 			go currencyApp.Start(ctx)
 			go marketApp.Start(ctx)
-			go l.startBlocksScan(ctx, reader)
+			go l.startBlocksScan(ctx, reader, orderbookapp.NewApplication(reader, l.orderClient, stateclient.Client()))
 		}()
 	}
 }
 
-func (l *Application) startBlocksScan(ctx context.Context, reader *coreum.Reader) {
+func (l *Application) startBlocksScan(ctx context.Context, reader *coreum.Reader, orderBookApp *orderbookapp.Application) {
 	logger.Infof("Start: Started scanner for network %s", reader.Network)
 	for {
 		select {
@@ -96,6 +103,10 @@ func (l *Application) startBlocksScan(ctx context.Context, reader *coreum.Reader
 			return
 		case block := <-reader.ProcessBlockChannel:
 			l.scannerCoordinator(ctx, block, reader.Network)
+			changes := orderBookApp.HandleBlock(ctx, block)
+			if l.publisher != nil {
+				l.publisher.Publish(ctx, changes)
+			}
 			l.state.SetState(reader.Network, reader.BlockHeight)
 		}
 	}
@@ -134,5 +145,5 @@ func (l *Application) scannerCoordinator(ctx context.Context, block *coreum.Scan
 }
 
 func (l *Application) StartOHLCProcessor(ctx context.Context) {
-	ohlc.NewApplication(ctx, l.tradeChan, l.tradeClient)
+	ohlc.NewApplication(ctx, l.tradeChan, l.tradeClient, l.publisher)
 }
@@ -10,6 +10,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/update"
 	decimal "github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	ohlcgrpc "github.com/CoreumFoundation/CoreDEX-API/domain/ohlc"
@@ -17,6 +18,7 @@ import (
 	orderproperties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
 	tradeclient "github.com/CoreumFoundation/CoreDEX-API/domain/trade/client"
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 )
 
@@ -27,10 +29,12 @@ type Application struct {
 	ohlcCache          []*ohlcgrpc.OHLC
 	ohlcCacheResetTime time.Time
 	mutex              *sync.RWMutex
+	publisher          *update.Publisher // Optional
 }
 
-func NewApplication(ctx context.Context, tradeChan chan *tradegrpc.Trade, tradeClient tradegrpc.TradeServiceClient) *Application {
+func NewApplication(ctx context.Context, tradeChan chan *tradegrpc.Trade, tradeClient tradegrpc.TradeServiceClient, publisher *update.Publisher) *Application {
 	app := &Application{
+		publisher:          publisher,
 		tradeChan:          tradeChan,
 		tradeClient:        tradeClient,
 		ohlcClient:         ohlcclient.Client(),
@@ -199,6 +203,32 @@ func (a *Application) calculateOHLCS(inputTrades map[string][]*tradegrpc.Trade)
 		go a.calculateOHLC(trades, symbol, wg)
 	}
 	wg.Wait()
+	a.publishChanges(inputTrades)
+}
+
+// publishChanges publishes the symbols (both directions) of which the OHLC has been updated, per network
+func (a *Application) publishChanges(inputTrades map[string][]*tradegrpc.Trade) {
+	if a.publisher == nil {
+		return
+	}
+	changes := make(map[metadata.Network]*updategrpc.Changes)
+	for sym, trades := range inputTrades {
+		if len(trades) == 0 || trades[0].MetaData == nil {
+			continue
+		}
+		network := trades[0].MetaData.Network
+		if _, ok := changes[network]; !ok {
+			changes[network] = &updategrpc.Changes{Network: network}
+		}
+		s := strings.Split(sym, "_")
+		if len(s) != 2 {
+			continue
+		}
+		changes[network].OHLCSymbols = append(changes[network].OHLCSymbols, sym, fmt.Sprintf("%s_%s", s[1], s[0]))
+	}
+	for _, c := range changes {
+		a.publisher.Publish(context.Background(), c)
+	}
 }
 
 func (a *Application) calculateOHLC(inputTrades []*tradegrpc.Trade, symbol string, wg *sync.WaitGroup) {
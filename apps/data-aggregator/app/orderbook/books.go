@@ -0,0 +1,295 @@
+package orderbook
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dec "github.com/shopspring/decimal"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+// bookOrder is an open order in an order book
+type bookOrder struct {
+	side      dextypes.Side
+	price     dec.Decimal
+	remaining sdkmath.Int
+	order     dextypes.Order
+}
+
+// book is an on chain order book (base denom/quote denom)
+type book struct {
+	baseDenom  string
+	quoteDenom string
+	orders     map[uint64]*bookOrder
+}
+
+// books holds the order books of a network, updated from the DEX events in the order in which they are emitted.
+// Not safe for concurrent use: The order books are only modified by the block scanner of the network.
+type books struct {
+	books     map[string]*book
+	sequences map[uint64]string // Order sequence => key of the order book of the order
+	// Orders which are placed but not (yet) saved into the order book: An order is placed, matched (reduced) and then
+	// either saved into the order book with the remaining quantity (created) or closed.
+	placed  map[uint64]dextypes.Order
+	changed map[string]bool
+}
+
+func newBooks() *books {
+	return &books{
+		books:     make(map[string]*book),
+		sequences: make(map[uint64]string),
+		placed:    make(map[uint64]dextypes.Order),
+		changed:   make(map[string]bool),
+	}
+}
+
+func bookKey(baseDenom, quoteDenom string) string {
+	return baseDenom + "_" + quoteDenom
+}
+
+// place registers an order placed by a transaction, it is added to the order book when it is created
+func (b *books) place(order dextypes.Order) {
+	b.placed[order.Sequence] = order
+}
+
+// create adds the placed order to its order book with the remaining quantity after matching.
+// Returns false if the order was not placed before (e.g. placed by a message which is not parsed).
+func (b *books) create(sequence uint64, remaining sdkmath.Int) bool {
+	order, ok := b.placed[sequence]
+	if !ok {
+		return false
+	}
+	delete(b.placed, sequence)
+	order.RemainingBaseQuantity = remaining
+	b.add(order)
+	return true
+}
+
+// add adds an open order to its order book
+func (b *books) add(order dextypes.Order) {
+	if order.Price == nil {
+		return
+	}
+	price, err := dec.NewFromString(order.Price.String())
+	if err != nil {
+		return
+	}
+	key := bookKey(order.BaseDenom, order.QuoteDenom)
+	if _, ok := b.books[key]; !ok {
+		b.books[key] = &book{
+			baseDenom:  order.BaseDenom,
+			quoteDenom: order.QuoteDenom,
+			orders:     make(map[uint64]*bookOrder),
+		}
+	}
+	b.books[key].orders[order.Sequence] = &bookOrder{
+		side:      order.Side,
+		price:     price,
+		remaining: order.RemainingBaseQuantity,
+		order:     order,
+	}
+	b.sequences[order.Sequence] = key
+	b.changed[key] = true
+}
+
+// reduce subtracts the executed base quantity (the coin of the base denom) from an order in the order book
+func (b *books) reduce(sequence uint64, coins ...sdk.Coin) {
+	key, ok := b.sequences[sequence]
+	if !ok {
+		return
+	}
+	o := b.books[key].orders[sequence]
+	for _, coin := range coins {
+		if coin.Denom != o.order.BaseDenom || coin.Amount.IsNil() {
+			continue
+		}
+		o.remaining = o.remaining.Sub(coin.Amount)
+		if o.remaining.IsNegative() {
+			o.remaining = sdkmath.ZeroInt()
+		}
+		b.changed[key] = true
+		return
+	}
+}
+
+// settle drops the orders placed by the transaction which were not saved into the order book: Orders which are fully
+// filled when placed, immediate-or-cancel and market orders are not always closed by an event. Called at the end of
+// every transaction, as the events of an order placement are all emitted by the transaction placing it.
+func (b *books) settle() {
+	clear(b.placed)
+}
+
+// close removes an order from its order book (filled, canceled or expired)
+func (b *books) close(sequence uint64) {
+	delete(b.placed, sequence)
+	key, ok := b.sequences[sequence]
+	if !ok {
+		return
+	}
+	delete(b.books[key].orders, sequence)
+	delete(b.sequences, sequence)
+	b.changed[key] = true
+}
+
+// bookKeyOf returns the key of the order book of a placed or open order
+func (b *books) bookKeyOf(sequence uint64) (string, bool) {
+	if key, ok := b.sequences[sequence]; ok {
+		return key, true
+	}
+	if order, ok := b.placed[sequence]; ok {
+		return bookKey(order.BaseDenom, order.QuoteDenom), true
+	}
+	return "", false
+}
+
+// chainBook is an order book with its orders as queried from the chain
+type chainBook struct {
+	baseDenom  string
+	quoteDenom string
+	orders     []dextypes.Order
+}
+
+// replace replaces all order books with the order books on chain. Order books which are not on chain anymore are
+// emptied, so that the stored order books are cleared. Returns the replaced order books, to compare them with drift.
+func (b *books) replace(chainBooks []chainBook) map[string]*book {
+	previous := b.books
+	b.books = make(map[string]*book)
+	b.sequences = make(map[uint64]string)
+	b.placed = make(map[uint64]dextypes.Order)
+	for _, cb := range chainBooks {
+		key := bookKey(cb.baseDenom, cb.quoteDenom)
+		b.books[key] = &book{
+			baseDenom:  cb.baseDenom,
+			quoteDenom: cb.quoteDenom,
+			orders:     make(map[uint64]*bookOrder),
+		}
+		for _, order := range cb.orders {
+			b.add(order)
+		}
+		b.changed[key] = true
+	}
+	for key, old := range previous {
+		if _, ok := b.books[key]; ok {
+			continue
+		}
+		b.books[key] = &book{
+			baseDenom:  old.baseDenom,
+			quoteDenom: old.quoteDenom,
+			orders:     make(map[uint64]*bookOrder),
+		}
+		b.changed[key] = true
+	}
+	return previous
+}
+
+// drift returns the number of orders which differ between the replaced order books and the current order books
+func (b *books) drift(previous map[string]*book) int {
+	drift := 0
+	for key, current := range b.books {
+		drift += diffOrders(previous[key], current)
+	}
+	for key, old := range previous {
+		if _, ok := b.books[key]; !ok {
+			drift += len(old.orders)
+		}
+	}
+	return drift
+}
+
+// diffOrders counts the orders which are only in one of the order books or have a different remaining quantity
+func diffOrders(old, current *book) int {
+	diff := 0
+	var oldOrders map[uint64]*bookOrder
+	if old != nil {
+		oldOrders = old.orders
+	}
+	for sequence, o := range current.orders {
+		if prev, ok := oldOrders[sequence]; !ok || !prev.remaining.Equal(o.remaining) {
+			diff++
+		}
+	}
+	for sequence := range oldOrders {
+		if _, ok := current.orders[sequence]; !ok {
+			diff++
+		}
+	}
+	return diff
+}
+
+// takeChanged returns the keys of the order books changed since the last call
+func (b *books) takeChanged() []string {
+	keys := make([]string, 0, len(b.changed))
+	for key := range b.changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	b.changed = make(map[string]bool)
+	return keys
+}
+
+// keys returns the keys of all order books
+func (b *books) keys() []string {
+	keys := make([]string, 0, len(b.books))
+	for key := range b.books {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// orderBook returns the order book in the order of execution: Buys descending and sells ascending by price, orders
+// with the same price by sequence (oldest first)
+func (b *books) orderBook(key string) *ordergrpc.OrderBook {
+	res := &ordergrpc.OrderBook{
+		Buy:  make([]*ordergrpc.OrderBookOrder, 0),
+		Sell: make([]*ordergrpc.OrderBookOrder, 0),
+	}
+	bk, ok := b.books[key]
+	if !ok {
+		return res
+	}
+	res.BaseDenom = bk.baseDenom
+	res.QuoteDenom = bk.quoteDenom
+	buys := make([]*bookOrder, 0)
+	sells := make([]*bookOrder, 0)
+	for _, o := range bk.orders {
+		if o.side == dextypes.SIDE_BUY {
+			buys = append(buys, o)
+		} else {
+			sells = append(sells, o)
+		}
+	}
+	sort.Slice(buys, func(i, j int) bool {
+		if !buys[i].price.Equal(buys[j].price) {
+			return buys[i].price.GreaterThan(buys[j].price)
+		}
+		return buys[i].order.Sequence < buys[j].order.Sequence
+	})
+	sort.Slice(sells, func(i, j int) bool {
+		if !sells[i].price.Equal(sells[j].price) {
+			return sells[i].price.LessThan(sells[j].price)
+		}
+		return sells[i].order.Sequence < sells[j].order.Sequence
+	})
+	for _, o := range buys {
+		res.Buy = append(res.Buy, o.orderBookOrder())
+	}
+	for _, o := range sells {
+		res.Sell = append(res.Sell, o.orderBookOrder())
+	}
+	return res
+}
+
+func (o *bookOrder) orderBookOrder() *ordergrpc.OrderBookOrder {
+	return &ordergrpc.OrderBookOrder{
+		Sequence:          o.order.Sequence,
+		Account:           o.order.Creator,
+		OrderID:           o.order.ID,
+		Price:             o.order.Price.String(),
+		Quantity:          o.order.Quantity.String(),
+		RemainingQuantity: o.remaining.String(),
+	}
+}
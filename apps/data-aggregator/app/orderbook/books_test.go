@@ -0,0 +1,131 @@
+package orderbook
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+func testOrder(sequence uint64, side dextypes.Side, price string, quantity int64) dextypes.Order {
+	p := dextypes.MustNewPriceFromString(price)
+	return dextypes.Order{
+		Creator:               "creator",
+		ID:                    "id",
+		Sequence:              sequence,
+		BaseDenom:             "base",
+		QuoteDenom:            "quote",
+		Price:                 &p,
+		Quantity:              sdkmath.NewInt(quantity),
+		RemainingBaseQuantity: sdkmath.NewInt(quantity),
+		Side:                  side,
+	}
+}
+
+func sequences(orders []*ordergrpc.OrderBookOrder) []uint64 {
+	res := make([]uint64, 0, len(orders))
+	for _, order := range orders {
+		res = append(res, order.Sequence)
+	}
+	return res
+}
+
+func Test_Books(t *testing.T) {
+	b := newBooks()
+	b.place(testOrder(1, dextypes.SIDE_SELL, "2", 100))
+	b.place(testOrder(2, dextypes.SIDE_BUY, "1", 50))
+	b.place(testOrder(3, dextypes.SIDE_SELL, "2", 10))
+	b.place(testOrder(4, dextypes.SIDE_SELL, "15e-1", 10))
+	b.place(testOrder(5, dextypes.SIDE_SELL, "3", 10))
+	// The book of a placed order is known: A taker is reduced before it is created or closed
+	if key, ok := b.bookKeyOf(4); !ok || key != "base_quote" {
+		t.Errorf("book of placed order %q, expected base_quote", key)
+	}
+	for _, sequence := range []uint64{1, 2, 3, 5} {
+		if !b.create(sequence, sdkmath.NewInt(map[uint64]int64{1: 100, 2: 50, 3: 10, 5: 10}[sequence])) {
+			t.Fatalf("order %d not placed", sequence)
+		}
+	}
+	// An IOC order is closed instead of created
+	b.close(4)
+	if b.create(4, sdkmath.NewInt(10)) {
+		t.Errorf("closed order created")
+	}
+	// A taker which is fully filled is neither created nor closed, it is dropped at the end of the transaction
+	b.place(testOrder(6, dextypes.SIDE_BUY, "3", 10))
+	b.settle()
+	if _, ok := b.bookKeyOf(6); ok || len(b.placed) != 0 {
+		t.Errorf("placed order %d kept after the transaction", 6)
+	}
+	// The book of an open order is known, the book of a closed order is not
+	if key, ok := b.bookKeyOf(1); !ok || key != "base_quote" {
+		t.Errorf("book of order %q, expected base_quote", key)
+	}
+	if _, ok := b.bookKeyOf(4); ok {
+		t.Errorf("book of closed order found")
+	}
+	// Only the coin of the base denom reduces the remaining quantity
+	b.reduce(1, sdk.NewCoin("quote", sdkmath.NewInt(80)), sdk.NewCoin("base", sdkmath.NewInt(40)))
+	b.close(5)
+
+	if changed := b.takeChanged(); len(changed) != 1 || changed[0] != "base_quote" {
+		t.Errorf("changed %v, expected [base_quote]", changed)
+	}
+	orderBook := b.orderBook("base_quote")
+	if got := sequences(orderBook.Sell); len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Errorf("sells %v, expected [1 3]", got)
+	}
+	if got := sequences(orderBook.Buy); len(got) != 1 || got[0] != 2 {
+		t.Errorf("buys %v, expected [2]", got)
+	}
+	if orderBook.Sell[0].RemainingQuantity != "60" || orderBook.Sell[0].Quantity != "100" {
+		t.Errorf("remaining %s of %s, expected 60 of 100", orderBook.Sell[0].RemainingQuantity, orderBook.Sell[0].Quantity)
+	}
+	if changed := b.takeChanged(); len(changed) != 0 {
+		t.Errorf("changed %v, expected none", changed)
+	}
+}
+
+func Test_Replace(t *testing.T) {
+	b := newBooks()
+	b.add(testOrder(1, dextypes.SIDE_SELL, "2", 100))
+	b.add(testOrder(2, dextypes.SIDE_BUY, "1", 50))
+	other := testOrder(3, dextypes.SIDE_BUY, "1", 50)
+	other.BaseDenom = "other"
+	b.add(other)
+	b.takeChanged()
+
+	// Order 1 has a different remaining quantity and order 4 is missing on chain, the order book of order 3 is gone
+	reduced := testOrder(1, dextypes.SIDE_SELL, "2", 100)
+	reduced.RemainingBaseQuantity = sdkmath.NewInt(70)
+	previous := b.replace([]chainBook{{
+		baseDenom:  "base",
+		quoteDenom: "quote",
+		orders:     []dextypes.Order{reduced, testOrder(2, dextypes.SIDE_BUY, "1", 50), testOrder(4, dextypes.SIDE_SELL, "3", 10)},
+	}})
+	if drift := b.drift(previous); drift != 3 {
+		t.Errorf("drift %d, expected 3", drift)
+	}
+	orderBook := b.orderBook("base_quote")
+	if got := sequences(orderBook.Sell); len(got) != 2 || got[0] != 1 || got[1] != 4 {
+		t.Errorf("sells %v, expected [1 4]", got)
+	}
+	if orderBook.Sell[0].RemainingQuantity != "70" {
+		t.Errorf("remaining %s, expected 70", orderBook.Sell[0].RemainingQuantity)
+	}
+	// The order book which is not on chain anymore is emptied, so that the stored order book is cleared
+	if orderBook := b.orderBook("other_quote"); orderBook.BaseDenom != "other" || len(orderBook.Buy) != 0 || len(orderBook.Sell) != 0 {
+		t.Errorf("removed order book %+v, expected an empty order book", orderBook)
+	}
+	if changed := b.takeChanged(); len(changed) != 2 || changed[0] != "base_quote" || changed[1] != "other_quote" {
+		t.Errorf("changed %v, expected [base_quote other_quote]", changed)
+	}
+	// Orders of removed order books are not tracked anymore
+	b.reduce(3, sdk.NewCoin("other", sdkmath.NewInt(10)))
+	if changed := b.takeChanged(); len(changed) != 0 {
+		t.Errorf("changed %v, expected none", changed)
+	}
+}
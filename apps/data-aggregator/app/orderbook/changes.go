@@ -0,0 +1,47 @@
+package orderbook
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+)
+
+// blockChanges collects the accounts and markets affected by the DEX events of a block
+type blockChanges struct {
+	accounts     map[string]bool
+	tradeSymbols map[string]bool // Order book keys of the reduced (matched) orders
+	txHashes     []string
+}
+
+func newBlockChanges() *blockChanges {
+	return &blockChanges{
+		accounts:     make(map[string]bool),
+		tradeSymbols: make(map[string]bool),
+		txHashes:     make([]string, 0),
+	}
+}
+
+func (c *blockChanges) toProto(network metadata.Network, block *coreum.ScannedBlock, changedBooks []string) *updategrpc.Changes {
+	return &updategrpc.Changes{
+		Network:          network,
+		BlockHeight:      block.BlockHeight,
+		BlockTime:        timestamppb.New(block.BlockTime),
+		TradeSymbols:     sortedKeys(c.tradeSymbols),
+		OrderBookSymbols: changedBooks,
+		Accounts:         sortedKeys(c.accounts),
+		TxHashes:         c.txHashes,
+	}
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,322 @@
+// Package orderbook maintains the order books of a network in memory from the DEX events of the scanned blocks.
+// The order books are reconciled with the chain periodically and published to the store, so that the api-server can
+// serve the order books without querying the node.
+package orderbook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	cmtypes "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/app/state"
+	dmn "github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/domain"
+	"github.com/CoreumFoundation/CoreDEX-API/apps/data-aggregator/domain/dex"
+	"github.com/CoreumFoundation/CoreDEX-API/coreum"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	orderclient "github.com/CoreumFoundation/CoreDEX-API/domain/order/client"
+	stategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/state"
+	stateclient "github.com/CoreumFoundation/CoreDEX-API/domain/state/client"
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+const (
+	// RECONCILE_INTERVAL is the interval in which the order books are replaced by the order books on chain, to correct
+	// any drift (e.g. orders placed by messages which are not parsed)
+	RECONCILE_INTERVAL = 5 * time.Minute
+	// reconcileRetryInterval is the interval in which a failed reconciliation is retried (e.g. the node has pruned the
+	// height of the block while the scanner is catching up)
+	reconcileRetryInterval = 30 * time.Second
+	queryTimeout           = 60 * time.Second
+)
+
+// reconciliation is a query of the order books on chain running in the background
+type reconciliation struct {
+	height int64
+	result chan reconcileResult
+	// Blocks processed after the height of the query, applied again to the order books of the chain
+	blocks []*coreum.ScannedBlock
+}
+
+type reconcileResult struct {
+	chainBooks []chainBook
+	err        error
+}
+
+type Application struct {
+	reader        *coreum.Reader
+	orderClient   ordergrpc.OrderServiceClient
+	stateClient   stategrpc.StateServiceClient
+	registry      *dmn.Registry
+	books         *books
+	reconciledAt  time.Time // Zero until the first reconciliation succeeded: The order books are not published before
+	nextReconcile time.Time
+	reconciling   *reconciliation // Nil if no reconciliation is running
+	nextSnapshot  time.Time
+	snapshots     map[string]*ordergrpc.OrderBookSnapshot // Last stored snapshot by order book
+}
+
+func NewApplication(reader *coreum.Reader, orderClient ordergrpc.OrderServiceClient, stateClient stategrpc.StateServiceClient) *Application {
+	registry := dmn.NewRegistry(ctypes.NewInterfaceRegistry())
+	registry.RegisterEventHandler(&dex.EventOrderPlacedHandler{})
+	registry.RegisterEventHandler(&dex.EventOrderCreatedHandler{})
+	registry.RegisterEventHandler(&dex.EventOrderReducedHandler{})
+	registry.RegisterEventHandler(&dex.EventOrderClosedHandler{})
+	return &Application{
+		reader:      reader,
+		orderClient: orderClient,
+		stateClient: stateClient,
+		registry:    registry,
+		books:       newBooks(),
+		snapshots:   make(map[string]*ordergrpc.OrderBookSnapshot),
+	}
+}
+
+// HandleBlock applies the DEX events of the block to the order books and publishes the changed order books.
+// Has to be called for every block in order, after the block has been processed by the other handlers.
+// Returns the changes of the block for the api-servers.
+func (a *Application) HandleBlock(ctx context.Context, block *coreum.ScannedBlock) *updategrpc.Changes {
+	changes := newBlockChanges()
+	a.applyBlock(ctx, block, changes)
+	a.reconcile(ctx, block)
+	changed := a.books.takeChanged()
+	if !a.reconciledAt.IsZero() {
+		a.publish(ctx, block, changed)
+		a.storeHeight(ctx, block)
+		snapshots := changed
+		if time.Now().After(a.nextSnapshot) {
+			snapshots = a.books.keys()
+			a.nextSnapshot = time.Now().Add(SNAPSHOT_INTERVAL)
+		}
+		a.snapshot(ctx, block, snapshots)
+	}
+	return changes.toProto(a.reader.Network, block, changed)
+}
+
+// applyBlock applies the DEX events of the transactions and of the end blocker of the block to the order books
+func (a *Application) applyBlock(ctx context.Context, block *coreum.ScannedBlock, changes *blockChanges) {
+	for _, transaction := range block.Transactions {
+		if transaction.Tx == nil || transaction.TxResponse == nil {
+			continue
+		}
+		changes.txHashes = append(changes.txHashes, transaction.TxResponse.TxHash)
+		msgs := make(map[string]*dextypes.MsgPlaceOrder)
+		for _, msg := range transaction.Tx.Body.Messages {
+			if msg.TypeUrl != sdk.MsgTypeURL(&dextypes.MsgPlaceOrder{}) {
+				continue
+			}
+			placeOrder := &dextypes.MsgPlaceOrder{}
+			if err := proto.Unmarshal(msg.Value, placeOrder); err != nil {
+				logger.Errorf("Error unmarshalling MsgPlaceOrder in tx %s: %v", transaction.TxResponse.TxHash, err)
+				continue
+			}
+			msgs[placeOrder.Sender+"/"+placeOrder.ID] = placeOrder
+		}
+		a.applyEvents(ctx, block.BlockHeight, transaction.TxResponse.Events, msgs, changes)
+		a.books.settle()
+	}
+	// Expired orders are closed in the end blocker
+	a.applyEvents(ctx, block.BlockHeight, block.BlockEvents, nil, changes)
+}
+
+func (a *Application) applyEvents(ctx context.Context, height int64, events []cmtypes.Event, msgs map[string]*dextypes.MsgPlaceOrder, changes *blockChanges) {
+	for _, ev := range a.registry.ParseEvents(events) {
+		switch event := ev.(type) {
+		case *dextypes.EventOrderPlaced:
+			changes.accounts[event.Creator] = true
+			msg, ok := msgs[event.Creator+"/"+event.ID]
+			if !ok {
+				continue
+			}
+			a.books.place(dextypes.Order{
+				Creator:     msg.Sender,
+				Type:        msg.Type,
+				ID:          msg.ID,
+				Sequence:    event.Sequence,
+				BaseDenom:   msg.BaseDenom,
+				QuoteDenom:  msg.QuoteDenom,
+				Price:       msg.Price,
+				Quantity:    msg.Quantity,
+				Side:        msg.Side,
+				GoodTil:     msg.GoodTil,
+				TimeInForce: msg.TimeInForce,
+			})
+		case *dextypes.EventOrderCreated:
+			changes.accounts[event.Creator] = true
+			if a.books.create(event.Sequence, event.RemainingBaseQuantity) {
+				continue
+			}
+			// The order was placed by a message which is not parsed (e.g. wrapped in an authz exec)
+			qctx, cancel := context.WithTimeout(coreum.AtHeight(ctx, height), queryTimeout)
+			order, err := a.reader.QueryOrder(qctx, event.Creator, event.ID)
+			cancel()
+			if err != nil {
+				// Can happen if the order is closed in the same block, otherwise corrected by the next reconciliation
+				logger.Warnf("Order book: order %d of %s not found at height %d: %v", event.Sequence, event.Creator, height, err)
+				continue
+			}
+			order.RemainingBaseQuantity = event.RemainingBaseQuantity
+			a.books.add(*order)
+		case *dextypes.EventOrderReduced:
+			changes.accounts[event.Creator] = true
+			if key, ok := a.books.bookKeyOf(event.Sequence); ok {
+				changes.tradeSymbols[key] = true
+			}
+			a.books.reduce(event.Sequence, event.SentCoin, event.ReceivedCoin)
+		case *dextypes.EventOrderClosed:
+			changes.accounts[event.Creator] = true
+			a.books.close(event.Sequence)
+		}
+	}
+}
+
+// reconcile replaces the order books with the order books on chain. The chain is queried in the background at the
+// height of a processed block, so that the queries do not hold up the block scanner: The blocks processed while the
+// query is running are kept and applied again to the order books of the chain once it completed.
+func (a *Application) reconcile(ctx context.Context, block *coreum.ScannedBlock) {
+	r := a.reconciling
+	if r == nil {
+		if time.Now().After(a.nextReconcile) {
+			a.reconciling = a.queryReconciliation(ctx, block.BlockHeight)
+		}
+		return
+	}
+	r.blocks = append(r.blocks, block)
+	var res reconcileResult
+	select {
+	case res = <-r.result:
+	default:
+		return
+	}
+	a.reconciling = nil
+	if res.err != nil {
+		logger.Warnf("Order book: reconciliation for %s at height %d failed: %v", a.reader.Network.String(), r.height, res.err)
+		a.nextReconcile = time.Now().Add(reconcileRetryInterval)
+		return
+	}
+	previous := a.books.replace(res.chainBooks)
+	for _, b := range r.blocks {
+		a.applyBlock(ctx, b, newBlockChanges())
+	}
+	if drift := a.books.drift(previous); drift > 0 && !a.reconciledAt.IsZero() {
+		logger.Warnf("Order book: %d orders differed from the chain for %s at height %d", drift, a.reader.Network.String(), block.BlockHeight)
+	}
+	a.reconciledAt = time.Now()
+	a.nextReconcile = a.reconciledAt.Add(RECONCILE_INTERVAL)
+}
+
+// queryReconciliation starts the query of the order books on chain at the height
+func (a *Application) queryReconciliation(ctx context.Context, height int64) *reconciliation {
+	r := &reconciliation{
+		height: height,
+		result: make(chan reconcileResult, 1),
+	}
+	go func() {
+		qctx, cancel := context.WithTimeout(coreum.AtHeight(ctx, height), queryTimeout)
+		defer cancel()
+		chainBooks, err := a.queryChainBooks(qctx)
+		r.result <- reconcileResult{chainBooks: chainBooks, err: err}
+	}()
+	return r
+}
+
+func (a *Application) queryChainBooks(ctx context.Context) ([]chainBook, error) {
+	orderBooks, err := a.reader.QueryAllOrderBooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chainBooks := make([]chainBook, 0, len(orderBooks))
+	for _, ob := range orderBooks {
+		cb := chainBook{baseDenom: ob.BaseDenom, quoteDenom: ob.QuoteDenom}
+		for _, side := range []dextypes.Side{dextypes.SIDE_BUY, dextypes.SIDE_SELL} {
+			orders, err := a.reader.QueryAllOrderBookOrders(ctx, ob.BaseDenom, ob.QuoteDenom, side)
+			if err != nil {
+				return nil, err
+			}
+			cb.orders = append(cb.orders, orders...)
+		}
+		chainBooks = append(chainBooks, cb)
+	}
+	return chainBooks, nil
+}
+
+// publish stores the order books which changed in the block (all order books after a reconciliation)
+func (a *Application) publish(ctx context.Context, block *coreum.ScannedBlock, keys []string) {
+	for _, key := range keys {
+		orderBook := a.books.orderBook(key)
+		if orderBook.BaseDenom == "" {
+			continue
+		}
+		orderBook.BlockHeight = block.BlockHeight
+		orderBook.BlockTime = timestamppb.New(block.BlockTime)
+		orderBook.ReconciledAt = timestamppb.New(a.reconciledAt)
+		orderBook.MetaData = &metadata.MetaData{
+			Network:   a.reader.Network,
+			UpdatedAt: timestamppb.Now(),
+			CreatedAt: timestamppb.Now(),
+		}
+		if _, err := a.orderClient.UpsertOrderBook(orderclient.AuthCtx(ctx), orderBook); err != nil {
+			logger.Errorf("Order book: storing %s for %s failed: %v", key, a.reader.Network.String(), err)
+		}
+	}
+}
+
+// storeHeight records the height up to which the order books in the store are maintained. Order books are only stored
+// when they change: The api-server compares this height with the chain to judge whether the order books are current.
+func (a *Application) storeHeight(ctx context.Context, block *coreum.ScannedBlock) {
+	content, err := json.Marshal(&state.Content{Height: block.BlockHeight})
+	if err != nil {
+		logger.Errorf("Order book: marshalling the height for %s failed: %v", a.reader.Network.String(), err)
+		return
+	}
+	_, err = a.stateClient.Upsert(stateclient.AuthCtx(ctx), &stategrpc.State{
+		StateType: stategrpc.StateType_ORDERBOOK_HEIGHT,
+		Content:   string(content),
+		MetaData: &metadata.MetaData{
+			Network:   a.reader.Network,
+			UpdatedAt: timestamppb.Now(),
+		},
+	})
+	if err != nil {
+		logger.Errorf("Order book: storing the height %d for %s failed: %v", block.BlockHeight, a.reader.Network.String(), err)
+	}
+}
+
+// snapshot stores the snapshots of the order books at the block. A snapshot with the same orders as the last stored
+// snapshot of the order book is skipped (e.g. a change past the depth of the snapshot, or the periodic pass over the
+// order books) unless that is older than SNAPSHOT_REFRESH, as is the snapshot of an empty order book without a
+// previous snapshot.
+func (a *Application) snapshot(ctx context.Context, block *coreum.ScannedBlock, keys []string) {
+	for _, key := range keys {
+		orderBook := a.books.orderBook(key)
+		if orderBook.BaseDenom == "" {
+			continue
+		}
+		snapshot := newSnapshot(orderBook, SNAPSHOT_DEPTH)
+		last, ok := a.snapshots[key]
+		unchanged := ok && sameOrders(last, snapshot) && block.BlockTime.Sub(last.BlockTime.AsTime()) < SNAPSHOT_REFRESH
+		if unchanged || !ok && len(snapshot.Buy) == 0 && len(snapshot.Sell) == 0 {
+			continue
+		}
+		snapshot.BlockHeight = block.BlockHeight
+		snapshot.BlockTime = timestamppb.New(block.BlockTime)
+		snapshot.MetaData = &metadata.MetaData{
+			Network:   a.reader.Network,
+			UpdatedAt: timestamppb.Now(),
+			CreatedAt: timestamppb.Now(),
+		}
+		if _, err := a.orderClient.UpsertOrderBookSnapshot(orderclient.AuthCtx(ctx), snapshot); err != nil {
+			logger.Errorf("Order book: storing the snapshot of %s for %s failed: %v", key, a.reader.Network.String(), err)
+			continue
+		}
+		a.snapshots[key] = snapshot
+	}
+}
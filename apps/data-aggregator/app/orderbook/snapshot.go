@@ -0,0 +1,98 @@
+package orderbook
+
+import (
+	"time"
+
+	dec "github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+)
+
+const (
+	// SNAPSHOT_DEPTH is the number of orders per side of an order book stored in a snapshot
+	SNAPSHOT_DEPTH = 50
+	// SNAPSHOT_INTERVAL is the interval in which all order books are snapshotted, in addition to the snapshots of the
+	// order books which changed in a block
+	SNAPSHOT_INTERVAL = time.Minute
+	// SNAPSHOT_REFRESH is the interval in which the snapshot of an unchanged order book is stored again, so that the
+	// point in time queries find a snapshot within the retention of the store
+	SNAPSHOT_REFRESH = 24 * time.Hour
+)
+
+// newSnapshot returns the snapshot of the first depth orders per side of the order book
+func newSnapshot(orderBook *ordergrpc.OrderBook, depth int) *ordergrpc.OrderBookSnapshot {
+	buy := orderBook.Buy
+	if len(buy) > depth {
+		buy = buy[:depth]
+	}
+	sell := orderBook.Sell
+	if len(sell) > depth {
+		sell = sell[:depth]
+	}
+	return &ordergrpc.OrderBookSnapshot{
+		BaseDenom:  orderBook.BaseDenom,
+		QuoteDenom: orderBook.QuoteDenom,
+		Buy:        buy,
+		Sell:       sell,
+		Metrics:    snapshotMetrics(buy, sell),
+	}
+}
+
+// sameOrders returns true if the snapshots hold the same orders
+func sameOrders(a, b *ordergrpc.OrderBookSnapshot) bool {
+	equal := func(x, y []*ordergrpc.OrderBookOrder) bool {
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !proto.Equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return equal(a.Buy, b.Buy) && equal(a.Sell, b.Sell)
+}
+
+// snapshotMetrics calculates the best prices and the depth of the orders (best price first)
+func snapshotMetrics(buy, sell []*ordergrpc.OrderBookOrder) *ordergrpc.OrderBookMetrics {
+	metrics := &ordergrpc.OrderBookMetrics{
+		BuyOrders:  int32(len(buy)),
+		SellOrders: int32(len(sell)),
+	}
+	base, quote := sideDepth(buy)
+	metrics.BuyDepth, metrics.BuyDepthQuote = base.String(), quote.String()
+	base, quote = sideDepth(sell)
+	metrics.SellDepth, metrics.SellDepthQuote = base.String(), quote.String()
+	// The on chain prices can be in exponent notation (e.g. 15e-1)
+	if len(buy) > 0 {
+		if price, err := dec.NewFromString(buy[0].Price); err == nil {
+			metrics.BestBuy = price.String()
+		}
+	}
+	if len(sell) > 0 {
+		if price, err := dec.NewFromString(sell[0].Price); err == nil {
+			metrics.BestSell = price.String()
+		}
+	}
+	return metrics
+}
+
+// sideDepth sums the remaining quantity and its value (quantity * price) of the orders
+func sideDepth(orders []*ordergrpc.OrderBookOrder) (dec.Decimal, dec.Decimal) {
+	base, quote := dec.Zero, dec.Zero
+	for _, order := range orders {
+		remaining, err := dec.NewFromString(order.RemainingQuantity)
+		if err != nil {
+			continue
+		}
+		price, err := dec.NewFromString(order.Price)
+		if err != nil {
+			continue
+		}
+		base = base.Add(remaining)
+		quote = quote.Add(remaining.Mul(price))
+	}
+	return base, quote
+}
@@ -0,0 +1,72 @@
+package orderbook
+
+import (
+	"testing"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+)
+
+func Test_NewSnapshot(t *testing.T) {
+	orderBook := &ordergrpc.OrderBook{
+		BaseDenom:  "base",
+		QuoteDenom: "quote",
+		Buy: []*ordergrpc.OrderBookOrder{
+			{Sequence: 1, Price: "2", Quantity: "100", RemainingQuantity: "50"},
+			{Sequence: 2, Price: "15e-1", Quantity: "10", RemainingQuantity: "10"},
+			{Sequence: 3, Price: "1", Quantity: "10", RemainingQuantity: "10"},
+		},
+		Sell: []*ordergrpc.OrderBookOrder{
+			{Sequence: 4, Price: "25e-1", Quantity: "20", RemainingQuantity: "20"},
+		},
+	}
+	snapshot := newSnapshot(orderBook, 2)
+	if len(snapshot.Buy) != 2 || len(snapshot.Sell) != 1 {
+		t.Fatalf("%d buys and %d sells, expected 2 and 1", len(snapshot.Buy), len(snapshot.Sell))
+	}
+	metrics := snapshot.Metrics
+	expected := &ordergrpc.OrderBookMetrics{
+		BestBuy:        "2",
+		BestSell:       "2.5",
+		BuyDepth:       "60",
+		BuyDepthQuote:  "115",
+		SellDepth:      "20",
+		SellDepthQuote: "50",
+		BuyOrders:      2,
+		SellOrders:     1,
+	}
+	if metrics.BestBuy != expected.BestBuy || metrics.BestSell != expected.BestSell ||
+		metrics.BuyDepth != expected.BuyDepth || metrics.BuyDepthQuote != expected.BuyDepthQuote ||
+		metrics.SellDepth != expected.SellDepth || metrics.SellDepthQuote != expected.SellDepthQuote ||
+		metrics.BuyOrders != expected.BuyOrders || metrics.SellOrders != expected.SellOrders {
+		t.Errorf("metrics %v, expected %v", metrics, expected)
+	}
+}
+
+func Test_NewSnapshotEmpty(t *testing.T) {
+	metrics := newSnapshot(&ordergrpc.OrderBook{BaseDenom: "base", QuoteDenom: "quote"}, SNAPSHOT_DEPTH).Metrics
+	if metrics.BestBuy != "" || metrics.BestSell != "" || metrics.BuyDepth != "0" || metrics.SellDepthQuote != "0" {
+		t.Errorf("metrics %v, expected no best prices and zero depth", metrics)
+	}
+}
+
+func Test_SameOrders(t *testing.T) {
+	snapshot := func(remaining ...string) *ordergrpc.OrderBookSnapshot {
+		s := &ordergrpc.OrderBookSnapshot{}
+		for i, r := range remaining {
+			s.Buy = append(s.Buy, &ordergrpc.OrderBookOrder{Sequence: uint64(i + 1), Price: "1", Quantity: "10", RemainingQuantity: r})
+		}
+		return s
+	}
+	if !sameOrders(snapshot("10", "5"), snapshot("10", "5")) {
+		t.Errorf("same orders differ")
+	}
+	if sameOrders(snapshot("10", "5"), snapshot("10", "4")) {
+		t.Errorf("reduced order not detected")
+	}
+	if sameOrders(snapshot("10", "5"), snapshot("10")) {
+		t.Errorf("closed order not detected")
+	}
+	if !sameOrders(snapshot(), &ordergrpc.OrderBookSnapshot{Buy: []*ordergrpc.OrderBookOrder{}}) {
+		t.Errorf("empty order books differ")
+	}
+}
@@ -0,0 +1,34 @@
+// Package update publishes the changes written by the data-aggregator to the api-servers (relayed by the store), so
+// that the api-servers only refresh the websocket subscriptions affected by the changes.
+package update
+
+import (
+	"context"
+	"time"
+
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	updateclient "github.com/CoreumFoundation/CoreDEX-API/domain/update/client"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const publishTimeout = 5 * time.Second
+
+type Publisher struct {
+	updateClient updategrpc.UpdateServiceClient
+}
+
+func NewPublisher(updateClient updategrpc.UpdateServiceClient) *Publisher {
+	return &Publisher{
+		updateClient: updateClient,
+	}
+}
+
+// Publish sends the changes to the subscribed api-servers. A failure is only logged: The api-servers detect the
+// missing block and refresh all subscriptions of the network.
+func (p *Publisher) Publish(ctx context.Context, changes *updategrpc.Changes) {
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	if _, err := p.updateClient.PublishChanges(updateclient.AuthCtx(ctx), changes); err != nil {
+		logger.Warnf("Publishing the changes (block %d) of %s failed: %v", changes.BlockHeight, changes.Network.String(), err)
+	}
+}
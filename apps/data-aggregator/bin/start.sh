@@ -23,6 +23,7 @@ export TRADE_STORE=localhost:50051
 export OHLC_STORE=localhost:50051
 export ORDER_STORE=localhost:50051
 export CURRENCY_STORE=localhost:50051
+export UPDATE_STORE=localhost:50051
 export LOG_LEVEL=info
 
 # Start stores:
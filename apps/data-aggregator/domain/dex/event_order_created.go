@@ -0,0 +1,54 @@
+package dex
+
+import (
+	"strconv"
+
+	"cosmossdk.io/math"
+	cmtypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
+)
+
+// EventOrderCreatedHandler parses the event emitted when the remainder of an order is saved into the order book
+// (after matching)
+type EventOrderCreatedHandler struct{}
+
+func (e *EventOrderCreatedHandler) EventType() proto.Message {
+	return &dextypes.EventOrderCreated{}
+}
+
+func (e *EventOrderCreatedHandler) Parse(event cmtypes.Event) proto.Message {
+	res := &dextypes.EventOrderCreated{}
+	for _, attribute := range event.Attributes {
+		switch attribute.Key {
+		case "creator":
+			res.Creator = attribute.Value
+		case "id":
+			res.ID = attribute.Value
+		case "sequence":
+			id, err := strconv.ParseUint(attribute.Value, 10, 64)
+			if err != nil {
+				logger.Errorf("failed to parse EventOrderCreated sequence %s as uint64 : %v", attribute.Value, err)
+				continue
+			}
+			res.Sequence = id
+		case "remaining_base_quantity":
+			value, ok := math.NewIntFromString(attribute.Value)
+			if !ok {
+				logger.Errorf("failed to parse EventOrderCreated remaining_base_quantity %s as integer", attribute.Value)
+				return nil
+			}
+			res.RemainingBaseQuantity = value
+		case "remaining_spendable_balance":
+			value, ok := math.NewIntFromString(attribute.Value)
+			if !ok {
+				logger.Errorf("failed to parse EventOrderCreated remaining_spendable_balance %s as integer", attribute.Value)
+				return nil
+			}
+			res.RemainingSpendableBalance = value
+		}
+	}
+	return res
+}
@@ -76,6 +76,7 @@ func (e *MsgCancelOrderHandler) Handle(
 		} else {
 			order.OrderStatus = ordermodel.OrderStatus_ORDER_STATUS_CANCELED
 		}
+		setEventTrigger(order, meta)
 		_, err = orderClient.Upsert(orderclient.AuthCtx(ctx), order)
 		if err != nil {
 			return err
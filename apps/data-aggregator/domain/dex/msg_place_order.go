@@ -121,6 +121,14 @@ func enrichDenoms(ctx context.Context, currencyClient currencygrpc.CurrencyServi
 	return enriched
 }
 
+// setEventTrigger records the transaction and block of the update with the order, the store records them with the
+// lifecycle event of the order (the TXID, BlockHeight and BlockTime of the order remain those of the placement)
+func setEventTrigger(order *ordergrpc.Order, meta domain.Metadata) {
+	order.EventTXID = meta.TxHash
+	order.EventBlockHeight = meta.BlockHeight
+	order.EventBlockTime = timestamppb.New(meta.BlockTime)
+}
+
 func (e *MsgPlaceOrderHandler) Handle(
 	ctx context.Context,
 	orderClient ordergrpc.OrderServiceClient,
@@ -165,6 +173,7 @@ func (e *MsgPlaceOrderHandler) Handle(
 				order.Enriched = true
 			}
 
+			setEventTrigger(order, meta)
 			_, err = orderClient.Upsert(orderclient.AuthCtx(ctx), order)
 			if err != nil {
 				return err
@@ -230,6 +239,7 @@ func (e *MsgPlaceOrderHandler) Handle(
 			if dec.New(order.RemainingQuantity.Value, order.RemainingQuantity.Exp).IsZero() {
 				order.OrderStatus = ordergrpc.OrderStatus_ORDER_STATUS_FILLED
 			}
+			setEventTrigger(order, meta)
 			_, err = orderClient.Upsert(orderclient.AuthCtx(ctx), order)
 			if err != nil {
 				return err
@@ -279,6 +289,7 @@ func (e *MsgPlaceOrderHandler) Handle(
 			if order.RemainingQuantity.Value == 0 {
 				order.RemainingQuantity.Exp = 0
 			}
+			setEventTrigger(order, meta)
 			_, err = orderClient.Upsert(orderclient.AuthCtx(ctx), order)
 			if err != nil {
 				return err
@@ -56,6 +56,17 @@ func (r *Registry) ParseEvent(typeURL string, event cmtypes.Event) proto.Message
 	return nil
 }
 
+// ParseEvents parses the events for which an event handler is registered, in the order of the events
+func (r *Registry) ParseEvents(events []cmtypes.Event) []proto.Message {
+	res := make([]proto.Message, 0)
+	for _, event := range events {
+		if msg := r.ParseEvent(event.Type, normalizeEvent(event)); msg != nil {
+			res = append(res, msg)
+		}
+	}
+	return res
+}
+
 func (r *Registry) HandleAction(
 	ctx context.Context,
 	orderClient ordergrpc.OrderServiceClient,
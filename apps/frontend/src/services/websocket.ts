@@ -1,5 +1,9 @@
 import { CoreumNetwork } from "coreum-js";
-import { Action, Subscription as Sub } from "coredex-api-types/update";
+import {
+  Action,
+  Subscription as Sub,
+  UpdateType,
+} from "coredex-api-types/update";
 import { Network } from "coredex-api-types/metadata";
 
 export const NetworkToEnum = (network: CoreumNetwork): Network => {
@@ -13,13 +17,16 @@ export const NetworkToEnum = (network: CoreumNetwork): Network => {
   }
 };
 
-export type Subscription = Omit<Sub, "Content"> & {
+export type Subscription = Omit<Sub, "Content" | "Sequence" | "Type"> & {
   Content?: any;
+  Sequence?: number;
+  Type?: UpdateType;
 };
 
 export interface WebSocketMessage {
   Action: Action;
   Subscription?: Subscription;
+  Error?: string;
 }
 
 export type MessageHandler = (data: any) => void;
@@ -72,6 +79,8 @@ class WebSocketManager {
   private pendingUnsubscriptions: Subscription[] = [];
   private subscriptions: Map<string, SubscriptionConfig> = new Map();
   public stateStore: Map<string, any> = new Map();
+  // Sequence of the last message per subscription key, to detect missed deltas
+  private sequences: Map<string, number> = new Map();
 
   private constructor() {
     this.connectedPromise = new Promise((resolve) => {
@@ -182,6 +191,7 @@ class WebSocketManager {
           this.pendingUnsubscriptions.push(subscription);
         }
         this.stateStore.delete(key);
+        this.sequences.delete(key);
       }
     }
   }
@@ -190,6 +200,12 @@ class WebSocketManager {
     try {
       if (data === "Connected") return;
       const message: WebSocketMessage = JSON.parse(data);
+      if (message.Action === Action.ERROR) {
+        console.warn("Websocket request failed:", message.Error, message.Subscription);
+        return;
+      }
+      // Acknowledgements carry no content
+      if (message.Action === Action.ACK) return;
       if (!message.Subscription) return;
       if (!("Content" in message.Subscription)) return;
 
@@ -197,8 +213,22 @@ class WebSocketManager {
 
       const config = this.subscriptions.get(key);
       if (!config) return;
+      // Type and Sequence are omitted when 0 (a snapshot, the first message of a channel)
+      const sequence = message.Subscription.Sequence ?? 0;
+      const isDelta = message.Subscription.Type === UpdateType.DELTA;
+      if (isDelta) {
+        const last = this.sequences.get(key);
+        if (last === undefined) return; // waiting for the snapshot
+        if (sequence !== last + 1) {
+          // A delta was missed: Request a new snapshot
+          this.sequences.delete(key);
+          this.sendSubscription(config.subscription);
+          return;
+        }
+      }
+      this.sequences.set(key, sequence);
       const newContent = JSON.parse(message.Subscription.Content);
-      const prevState = this.stateStore.get(key) || [];
+      const prevState = isDelta ? this.stateStore.get(key) || [] : [];
       const newState = config.updateFn
         ? config.updateFn(prevState, newContent)
         : newContent;
@@ -225,6 +255,7 @@ class WebSocketManager {
     this.pendingSubscriptions = [];
     this.pendingUnsubscriptions = [];
     this.stateStore.clear();
+    this.sequences.clear();
   }
 
   public connected(): Promise<void> {
@@ -233,6 +264,7 @@ class WebSocketManager {
 
   public clearState() {
     this.stateStore.clear();
+    this.sequences.clear();
     this.subscriptions.clear();
     this.pendingSubscriptions = [];
     this.pendingUnsubscriptions = [];
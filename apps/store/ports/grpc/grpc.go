@@ -8,12 +8,15 @@ import (
 	order "github.com/CoreumFoundation/CoreDEX-API/apps/store/ports/grpc/order"
 	state "github.com/CoreumFoundation/CoreDEX-API/apps/store/ports/grpc/state"
 	trade "github.com/CoreumFoundation/CoreDEX-API/apps/store/ports/grpc/trade"
+	update "github.com/CoreumFoundation/CoreDEX-API/apps/store/ports/grpc/update"
 	"github.com/CoreumFoundation/CoreDEX-API/apps/store/store"
 	currencygrpc "github.com/CoreumFoundation/CoreDEX-API/domain/currency"
 	ohlcgrpc "github.com/CoreumFoundation/CoreDEX-API/domain/ohlc"
 	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	stategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/state"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
 )
 
 type GrpcServer struct {
@@ -32,5 +35,6 @@ func NewGrpcServer() *GrpcServer {
 	tradegrpc.RegisterTradeServiceServer(s, trade.NewGrpcServer(storeClient))
 	ohlcgrpc.RegisterOHLCServiceServer(s, ohlc.NewGrpcServer(storeClient))
 	currencygrpc.RegisterCurrencyServiceServer(s, currency.NewGrpcServer(storeClient))
+	updategrpc.RegisterUpdateServiceServer(s, update.NewGrpcServer(broker.New(update.BROKER_ENDPOINT)))
 	return g
 }
@@ -55,3 +55,57 @@ func (s *GrpcServer) BatchUpsert(ctx context.Context, in *ordergrpc.Orders) (*pb
 	}
 	return &pb.Empty{}, nil
 }
+
+func (s *GrpcServer) UpsertOrderBook(ctx context.Context, in *ordergrpc.OrderBook) (*pb.Empty, error) {
+	err := s.store.Order.UpsertOrderBook(in)
+	if err != nil {
+		logger.Errorf("Order: UpsertOrderBook failed for %s/%s with error %v", in.BaseDenom, in.QuoteDenom, err)
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *GrpcServer) GetOrderBook(ctx context.Context, in *ordergrpc.OrderBookID) (*ordergrpc.OrderBook, error) {
+	st, err := s.store.Order.GetOrderBook(in)
+	if err != nil {
+		logger.Warnf("GetOrderBook failed for %+v with error %v", in, err)
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s *GrpcServer) UpsertOrderBookSnapshot(ctx context.Context, in *ordergrpc.OrderBookSnapshot) (*pb.Empty, error) {
+	err := s.store.Order.UpsertOrderBookSnapshot(in)
+	if err != nil {
+		logger.Errorf("Order: UpsertOrderBookSnapshot failed for %s/%s with error %v", in.BaseDenom, in.QuoteDenom, err)
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *GrpcServer) GetOrderBookSnapshot(ctx context.Context, in *ordergrpc.OrderBookSnapshotQuery) (*ordergrpc.OrderBookSnapshot, error) {
+	st, err := s.store.Order.GetOrderBookSnapshot(in)
+	if err != nil {
+		logger.Warnf("GetOrderBookSnapshot failed for %+v with error %v", in, err)
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s *GrpcServer) GetOrderBookSnapshotSeries(ctx context.Context, in *ordergrpc.OrderBookSnapshotSeriesFilter) (*ordergrpc.OrderBookSnapshots, error) {
+	st, err := s.store.Order.GetOrderBookSnapshotSeries(in)
+	if err != nil {
+		logger.Errorf("GetOrderBookSnapshotSeries with filter %+v failed with error %v", in, err)
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s *GrpcServer) GetOrderEvents(ctx context.Context, in *ordergrpc.OrderEventFilter) (*ordergrpc.OrderEvents, error) {
+	st, err := s.store.Order.GetOrderEvents(in)
+	if err != nil {
+		logger.Errorf("GetOrderEvents with filter %+v failed with error %v", in, err)
+		return nil, err
+	}
+	return st, nil
+}
@@ -0,0 +1,135 @@
+// Package grpc relays the changes of the data-aggregator to the subscribed api-servers.
+// The changes are not stored: Subscribers only receive the changes published while they are subscribed.
+// The data-aggregator and the api-servers can be connected to different store replicas: The published changes are
+// distributed over the broker to all replicas, which send them to their subscribers.
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	pb "google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	updategrpc "github.com/CoreumFoundation/CoreDEX-API/domain/update"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/broker"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const (
+	// BROKER_ENDPOINT is the environment variable with the URL of the broker between the store replicas
+	BROKER_ENDPOINT = "CHANGES_BROKER"
+	CHANGES_TOPIC   = "coredex.store.changes"
+	// subscriberBuffer is the number of changes buffered per subscriber. Changes are dropped for subscribers which do
+	// not keep up, the subscriber detects the gap in the block heights.
+	subscriberBuffer = 100
+	retryInterval    = 5 * time.Second
+)
+
+type GrpcServer struct {
+	broker      broker.Broker
+	mutex       sync.RWMutex
+	subscribers map[chan *updategrpc.Changes]metadata.Network
+}
+
+func NewGrpcServer(b broker.Broker) *GrpcServer {
+	s := &GrpcServer{
+		broker:      b,
+		subscribers: make(map[chan *updategrpc.Changes]metadata.Network),
+	}
+	// Subscribe before serving so that no published changes are missed
+	changes := s.subscribeChanges(context.Background())
+	go s.relay(context.Background(), changes)
+	return s
+}
+
+// PublishChanges distributes the changes to the subscribers of all store replicas
+func (s *GrpcServer) PublishChanges(ctx context.Context, in *updategrpc.Changes) (*pb.Empty, error) {
+	b, err := proto.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.broker.Publish(ctx, CHANGES_TOPIC, b); err != nil {
+		logger.Warnf("Update: publishing the changes of block %d of %s failed: %v", in.BlockHeight, in.Network.String(), err)
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+// relay sends the changes of the broker to the subscribers of this replica, resubscribing when the subscription ends
+// (e.g. lost connection to the broker). Changes published meanwhile are lost, the subscribers detect the gap.
+func (s *GrpcServer) relay(ctx context.Context, changes <-chan []byte) {
+	for {
+		data, ok := <-changes
+		if !ok {
+			if changes = s.subscribeChanges(ctx); changes == nil {
+				return
+			}
+			continue
+		}
+		in := &updategrpc.Changes{}
+		if err := proto.Unmarshal(data, in); err != nil {
+			logger.Warnf("Update: invalid changes: %v", err)
+			continue
+		}
+		s.send(in)
+	}
+}
+
+// subscribeChanges subscribes to the changes of the broker, retrying until the context is done (nil)
+func (s *GrpcServer) subscribeChanges(ctx context.Context) <-chan []byte {
+	for {
+		changes, err := s.broker.Subscribe(ctx, CHANGES_TOPIC)
+		if err == nil {
+			return changes
+		}
+		logger.Warnf("Update: subscribing to the changes failed: %v", err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// send sends the changes to the subscribers of this replica, without blocking on subscribers which do not keep up
+func (s *GrpcServer) send(in *updategrpc.Changes) {
+	s.mutex.RLock()
+	for subscriber, network := range s.subscribers {
+		if network != metadata.Network_NETWORK_DO_NOT_USE && network != in.Network {
+			continue
+		}
+		select {
+		case subscriber <- in:
+		default:
+			logger.Warnf("Update: subscriber is not keeping up, changes of block %d of %s dropped", in.BlockHeight, in.Network.String())
+		}
+	}
+	s.mutex.RUnlock()
+}
+
+func (s *GrpcServer) SubscribeChanges(in *updategrpc.ChangesFilter, stream grpc.ServerStreamingServer[updategrpc.Changes]) error {
+	subscriber := make(chan *updategrpc.Changes, subscriberBuffer)
+	s.mutex.Lock()
+	s.subscribers[subscriber] = in.Network
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.subscribers, subscriber)
+		s.mutex.Unlock()
+	}()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case changes := <-subscriber:
+			if err := stream.Send(changes); err != nil {
+				logger.Warnf("Update: sending the changes of block %d of %s failed: %v", changes.BlockHeight, changes.Network.String(), err)
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package order
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
+	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+)
+
+const orderEventFields = `Type,
+Account,
+Sequence,
+OrderID,
+BaseDenom,
+QuoteDenom,
+Side,
+Price,
+Quantity,
+RemainingQuantity,
+TXID,
+BlockHeight,
+BlockTime,
+MetaData `
+
+// maxOrderEvents is the maximum number of events returned by GetOrderEvents
+const maxOrderEvents = 1000
+
+// insertOrderEvent records the event in the transaction of the upsert of the order.
+// An event which is already recorded (e.g. a block which is processed again) is ignored.
+func insertOrderEvent(tx *sql.Tx, event *ordergrpc.OrderEvent, network metadata.Network) error {
+	baseDenom, err := json.Marshal(event.BaseDenom)
+	if err != nil {
+		return err
+	}
+	quoteDenom, err := json.Marshal(event.QuoteDenom)
+	if err != nil {
+		return err
+	}
+	quantity, err := json.Marshal(event.Quantity)
+	if err != nil {
+		return err
+	}
+	remainingQuantity, err := json.Marshal(event.RemainingQuantity)
+	if err != nil {
+		return err
+	}
+	blockTime, err := json.Marshal(event.BlockTime)
+	if err != nil {
+		return err
+	}
+	metaData, err := json.Marshal(event.MetaData)
+	if err != nil {
+		return err
+	}
+	// The remaining quantity distinguishes the partial fills of the order
+	remainingQuantityString := "0"
+	if event.RemainingQuantity != nil {
+		remainingQuantityString = fmt.Sprintf("%de%d", event.RemainingQuantity.Value, event.RemainingQuantity.Exp)
+	}
+	_, err = tx.Exec(`INSERT IGNORE INTO OrderEvent ( `+orderEventFields+`,
+		BaseDenomString,
+		QuoteDenomString,
+		RemainingQuantityString,
+		Network )
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		event.Type,
+		event.Account,
+		event.Sequence,
+		event.OrderID,
+		baseDenom,
+		quoteDenom,
+		event.Side,
+		event.Price,
+		quantity,
+		remainingQuantity,
+		event.TXID,
+		event.BlockHeight,
+		blockTime,
+		metaData,
+		denomString(event.BaseDenom),
+		denomString(event.QuoteDenom),
+		remainingQuantityString,
+		network)
+	return err
+}
+
+// GetOrderEvents returns the events of the orders of the account, newest first
+func (a *Application) GetOrderEvents(filter *ordergrpc.OrderEventFilter) (*ordergrpc.OrderEvents, error) {
+	if filter.Account == "" {
+		return nil, fmt.Errorf("account is required")
+	}
+	var queryBuilder strings.Builder
+	var args []interface{}
+
+	queryBuilder.WriteString(`
+	SELECT ` + orderEventFields + `
+	FROM OrderEvent
+	WHERE Network=?
+		AND Account=?`)
+	args = append(args, filter.Network, filter.Account)
+	if filter.Denom1 != nil && filter.Denom2 != nil {
+		queryBuilder.WriteString(`
+		AND ((BaseDenomString=? AND QuoteDenomString=?) OR (BaseDenomString=? AND QuoteDenomString=?))`)
+		args = append(args, *filter.Denom1, *filter.Denom2, *filter.Denom2, *filter.Denom1)
+	}
+	if filter.From != nil && filter.From.AsTime().Unix() > 0 {
+		queryBuilder.WriteString(" AND BlockTimeSeconds >= ?")
+		args = append(args, filter.From.AsTime().Unix())
+	}
+	if filter.To != nil && filter.To.AsTime().Unix() > 0 {
+		queryBuilder.WriteString(" AND BlockTimeSeconds < ?")
+		args = append(args, filter.To.AsTime().Unix())
+	}
+	queryBuilder.WriteString(" ORDER BY BlockTimeSeconds DESC, BlockHeight DESC, Sequence DESC LIMIT ?")
+	args = append(args, maxOrderEvents)
+
+	rows, err := a.client.Client.Query(queryBuilder.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &ordergrpc.OrderEvents{Events: make([]*ordergrpc.OrderEvent, 0)}
+	for rows.Next() {
+		event, err := mapToOrderEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func denomString(d *denom.Denom) string {
+	if d == nil {
+		return ""
+	}
+	return d.ToString()
+}
+
+func mapToOrderEvent(b *sql.Rows) (*ordergrpc.OrderEvent, error) {
+	event := &ordergrpc.OrderEvent{}
+	baseDenom := make([]byte, 0)
+	quoteDenom := make([]byte, 0)
+	quantity := make([]byte, 0)
+	remainingQuantity := make([]byte, 0)
+	blockTime := make([]byte, 0)
+	metaData := make([]byte, 0)
+	err := b.Scan(
+		&event.Type,
+		&event.Account,
+		&event.Sequence,
+		&event.OrderID,
+		&baseDenom,
+		&quoteDenom,
+		&event.Side,
+		&event.Price,
+		&quantity,
+		&remainingQuantity,
+		&event.TXID,
+		&event.BlockHeight,
+		&blockTime,
+		&metaData,
+	)
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal(baseDenom, &event.BaseDenom)
+	json.Unmarshal(quoteDenom, &event.QuoteDenom)
+	json.Unmarshal(quantity, &event.Quantity)
+	json.Unmarshal(remainingQuantity, &event.RemainingQuantity)
+	json.Unmarshal(blockTime, &event.BlockTime)
+	json.Unmarshal(metaData, &event.MetaData)
+	return event, nil
+}
@@ -3,11 +3,13 @@ package order
 import (
 	"database/sql"
 	"encoding/json"
-	"fmt"
 	"strings"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/CoreumFoundation/CoreDEX-API/domain/cursor"
 	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
 	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
 	store "github.com/CoreumFoundation/CoreDEX-API/utils/mysqlstore"
@@ -33,6 +35,12 @@ BlockHeight,
 OrderStatus,
 Network `
 
+// Default and maximum page size for GetAll when paginating
+const (
+	defaultLimit = 50
+	maxLimit     = 1000
+)
+
 type Application struct {
 	client store.StoreBase
 }
@@ -43,6 +51,7 @@ func NewApplication(client *store.StoreBase) *Application {
 	}
 	app.schema()
 	app.index()
+	go app.pruneSnapshots()
 	return app
 }
 
@@ -70,7 +79,7 @@ func (a *Application) Get(in *ordergrpc.ID) (*ordergrpc.Order, error) {
 			return nil, err
 		}
 	} else {
-		return nil, fmt.Errorf("no order found with Sequence=%d, Network=%d", in.Sequence, in.Network)
+		return nil, status.Errorf(codes.NotFound, "no order found with Sequence=%d, Network=%d", in.Sequence, in.Network)
 	}
 
 	return order, nil
@@ -133,7 +142,32 @@ func (a *Application) GetAll(filter *ordergrpc.Filter) (*ordergrpc.Orders, error
 		queryBuilder.WriteString(" AND OrderStatus=?")
 		args = append(args, *filter.OrderStatus)
 	}
-	queryBuilder.WriteString(" ORDER BY BlockTimeSeconds DESC")
+	// Pagination is optional for orders: Without a cursor or limit all matching orders are returned
+	paginate := (filter.Cursor != nil && *filter.Cursor != "") || (filter.Limit != nil && *filter.Limit > 0)
+	limit := 0
+	if paginate {
+		if filter.Cursor != nil && *filter.Cursor != "" {
+			c, err := cursor.Decode(*filter.Cursor)
+			if err != nil {
+				return nil, err
+			}
+			// Sequence is unique per network and does not change when the order is updated (BlockHeight does)
+			queryBuilder.WriteString(" AND Sequence < ?")
+			args = append(args, c.Sequence)
+		}
+		limit = defaultLimit
+		if filter.Limit != nil && *filter.Limit > 0 {
+			limit = int(*filter.Limit)
+		}
+		if limit > maxLimit {
+			limit = maxLimit
+		}
+		queryBuilder.WriteString(" ORDER BY Sequence DESC LIMIT ?")
+		// Retrieve one more than requested to determine if there is a next page
+		args = append(args, limit+1)
+	} else {
+		queryBuilder.WriteString(" ORDER BY BlockTimeSeconds DESC")
+	}
 	rows, err := a.client.Client.Query(queryBuilder.String(), args...)
 	if err != nil {
 		return nil, err
@@ -154,7 +188,13 @@ func (a *Application) GetAll(filter *ordergrpc.Filter) (*ordergrpc.Orders, error
 		return nil, err
 	}
 
-	return &ordergrpc.Orders{Orders: orders}, nil
+	res := &ordergrpc.Orders{Orders: orders}
+	if paginate && len(orders) > limit {
+		res.Orders = orders[:limit]
+		next := (&cursor.Cursor{Sequence: res.Orders[limit-1].Sequence}).Encode()
+		res.NextCursor = &next
+	}
+	return res, nil
 }
 
 func (a *Application) Upsert(in *ordergrpc.Order) error {
@@ -203,7 +243,19 @@ func (a *Application) Upsert(in *ordergrpc.Order) error {
 		logger.Errorf("Error marshalling timeInForce for order %s-%d-%s: %v", in.OrderID, in.Sequence, in.MetaData.Network.String(), err)
 		return err
 	}
-	_, err = a.client.Client.Exec(`INSERT INTO OrderData ( `+OrderDataFields+` ) 
+	tx, err := a.client.Client.Begin()
+	if err != nil {
+		logger.Errorf("Error starting transaction: %v", err)
+		return err
+	}
+	// The previous state of the order determines the lifecycle event of the update
+	previous, err := previousOrder(tx, in)
+	if err != nil {
+		tx.Rollback()
+		logger.Errorf("Error retrieving the previous order %s-%d-%s: %v", in.OrderID, in.Sequence, in.MetaData.Network.String(), err)
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO OrderData ( `+OrderDataFields+` ) 
         VALUES (?, ?, ?, ?, ?,
 			    ?, ?, ?, ?, ?,
 				?, ?, ?, ?, ?,
@@ -247,12 +299,46 @@ func (a *Application) Upsert(in *ordergrpc.Order) error {
 		in.OrderStatus,
 		in.OrderFee)
 	if err != nil {
+		tx.Rollback()
 		logger.Errorf("Error upserting order %s-%d-%s: %v", in.OrderID, in.Sequence, in.MetaData.Network.String(), err)
 		return err
 	}
+	if event := ordergrpc.NewOrderEvent(previous, in); event != nil {
+		if err = insertOrderEvent(tx, event, in.MetaData.Network); err != nil {
+			tx.Rollback()
+			logger.Errorf("Error inserting the %s event of order %s-%d-%s: %v", event.Type.String(), in.OrderID, in.Sequence, in.MetaData.Network.String(), err)
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		logger.Errorf("Error committing order %s-%d-%s: %v", in.OrderID, in.Sequence, in.MetaData.Network.String(), err)
+		return err
+	}
 	return nil
 }
 
+// previousOrder locks the stored order for the update, nil if the order is new.
+// Only the status and the remaining quantity are retrieved, which is what the event of the update depends on.
+func previousOrder(tx *sql.Tx, in *ordergrpc.Order) (*ordergrpc.Order, error) {
+	var orderStatus sql.NullInt64
+	remainingQuantity := make([]byte, 0)
+	err := tx.QueryRow(`SELECT OrderStatus, RemainingQuantity FROM OrderData WHERE Sequence=? AND Network=? FOR UPDATE`,
+		in.Sequence,
+		in.MetaData.Network).Scan(&orderStatus, &remainingQuantity)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	previous := &ordergrpc.Order{}
+	if orderStatus.Valid {
+		previous.OrderStatus = ordergrpc.OrderStatus(orderStatus.Int64)
+	}
+	json.Unmarshal(remainingQuantity, &previous.RemainingQuantity)
+	return previous, nil
+}
+
 func (a *Application) BatchUpsert(orders *ordergrpc.Orders) error {
 	for _, order := range orders.Orders {
 		err := a.Upsert(order)
@@ -0,0 +1,125 @@
+package order
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+func (a *Application) GetOrderBook(in *ordergrpc.OrderBookID) (*ordergrpc.OrderBook, error) {
+	rows, err := a.client.Client.Query(`
+	SELECT BaseDenom, QuoteDenom, Buy, Sell, BlockHeight, BlockTime, ReconciledAt, MetaData
+	FROM OrderBook
+	WHERE
+		Network=?
+		AND BaseDenom=?
+		AND QuoteDenom=?`,
+		in.Network,
+		in.BaseDenom,
+		in.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, fmt.Errorf("no order book found for %s/%s, Network=%d", in.BaseDenom, in.QuoteDenom, in.Network)
+	}
+	orderBook := &ordergrpc.OrderBook{}
+	buy := make([]byte, 0)
+	sell := make([]byte, 0)
+	blockTime := make([]byte, 0)
+	reconciledAt := make([]byte, 0)
+	metaData := make([]byte, 0)
+	err = rows.Scan(
+		&orderBook.BaseDenom,
+		&orderBook.QuoteDenom,
+		&buy,
+		&sell,
+		&orderBook.BlockHeight,
+		&blockTime,
+		&reconciledAt,
+		&metaData,
+	)
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal(buy, &orderBook.Buy)
+	json.Unmarshal(sell, &orderBook.Sell)
+	json.Unmarshal(blockTime, &orderBook.BlockTime)
+	json.Unmarshal(reconciledAt, &orderBook.ReconciledAt)
+	json.Unmarshal(metaData, &orderBook.MetaData)
+	return orderBook, nil
+}
+
+func (a *Application) UpsertOrderBook(in *ordergrpc.OrderBook) error {
+	// Empty sides are stored as empty arrays instead of null
+	if in.Buy == nil {
+		in.Buy = make([]*ordergrpc.OrderBookOrder, 0)
+	}
+	if in.Sell == nil {
+		in.Sell = make([]*ordergrpc.OrderBookOrder, 0)
+	}
+	buy, err := json.Marshal(in.Buy)
+	if err != nil {
+		logger.Errorf("Error marshalling buy orders for order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	sell, err := json.Marshal(in.Sell)
+	if err != nil {
+		logger.Errorf("Error marshalling sell orders for order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	blockTime, err := json.Marshal(in.BlockTime)
+	if err != nil {
+		logger.Errorf("Error marshalling blockTime for order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	reconciledAt, err := json.Marshal(in.ReconciledAt)
+	if err != nil {
+		logger.Errorf("Error marshalling reconciledAt for order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	if in.MetaData.CreatedAt == nil {
+		in.MetaData.CreatedAt = timestamppb.Now()
+	}
+	in.MetaData.UpdatedAt = timestamppb.Now()
+	metaData, err := json.Marshal(in.MetaData)
+	if err != nil {
+		logger.Errorf("Error marshalling metadata for order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	_, err = a.client.Client.Exec(`INSERT INTO OrderBook (BaseDenom, QuoteDenom, Buy, Sell, BlockHeight, BlockTime, ReconciledAt, MetaData, Network)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE Buy=?,
+		Sell=?,
+		BlockHeight=?,
+		BlockTime=?,
+		ReconciledAt=?,
+		MetaData=?`,
+		in.BaseDenom,
+		in.QuoteDenom,
+		buy,
+		sell,
+		in.BlockHeight,
+		blockTime,
+		reconciledAt,
+		metaData,
+		in.MetaData.Network,
+
+		buy,
+		sell,
+		in.BlockHeight,
+		blockTime,
+		reconciledAt,
+		metaData)
+	if err != nil {
+		logger.Errorf("Error upserting order book %s/%s-%s: %v", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String(), err)
+		return err
+	}
+	return nil
+}
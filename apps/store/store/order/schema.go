@@ -58,6 +58,68 @@ func (a *Application) createTables() {
 	if err != nil {
 		logger.Fatalf("Error creating historical table OrderDataHistory: %v", err)
 	}
+	// The order books as maintained by the data-aggregator (one record per on chain order book)
+	_, err = a.client.Client.Exec(`CREATE TABLE IF NOT EXISTS OrderBook (
+		BaseDenom VARCHAR(255),
+		QuoteDenom VARCHAR(255),
+		Buy JSON,
+		Sell JSON,
+		BlockHeight BIGINT,
+		BlockTime JSON,
+		ReconciledAt JSON,
+		MetaData JSON,
+		Network INT,
+		UNIQUE KEY (Network, BaseDenom, QuoteDenom)
+	)`)
+	if err != nil {
+		logger.Fatalf("Error creating table OrderBook: %v", err)
+	}
+	// Snapshots of the top of the order books, for point in time queries and time series
+	_, err = a.client.Client.Exec(`CREATE TABLE IF NOT EXISTS OrderBookSnapshot (
+		BaseDenom VARCHAR(255),
+		QuoteDenom VARCHAR(255),
+		Buy JSON,
+		Sell JSON,
+		BlockHeight BIGINT,
+		BlockTime JSON,
+		BlockTimeSeconds BIGINT AS (JSON_UNQUOTE(JSON_EXTRACT(BlockTime, '$.seconds'))) STORED,
+		Metrics JSON,
+		MetaData JSON,
+		Network INT,
+		UNIQUE KEY (Network, BaseDenom, QuoteDenom, BlockHeight),
+		INDEX (Network, BaseDenom, QuoteDenom, BlockTimeSeconds)
+	)`)
+	if err != nil {
+		logger.Fatalf("Error creating table OrderBookSnapshot: %v", err)
+	}
+	// The lifecycle events of the orders, recorded by the Upsert of the orders
+	_, err = a.client.Client.Exec(`CREATE TABLE IF NOT EXISTS OrderEvent (
+		Type INT,
+		Account VARCHAR(255),
+		Sequence BIGINT,
+		OrderID VARCHAR(255),
+		BaseDenom JSON,
+		QuoteDenom JSON,
+		BaseDenomString VARCHAR(255),
+		QuoteDenomString VARCHAR(255),
+		Side INT,
+		Price DOUBLE,
+		Quantity JSON,
+		RemainingQuantity JSON,
+		RemainingQuantityString VARCHAR(100),
+		TXID VARCHAR(255),
+		BlockHeight BIGINT,
+		BlockTime JSON,
+		BlockTimeSeconds BIGINT AS (JSON_UNQUOTE(JSON_EXTRACT(BlockTime, '$.seconds'))) STORED,
+		MetaData JSON,
+		Network INT,
+		UNIQUE KEY (Network, Sequence, Type, RemainingQuantityString),
+		INDEX (Network, Account, BlockTimeSeconds),
+		INDEX (Network, Account, BaseDenomString, QuoteDenomString, BlockTimeSeconds)
+	)`)
+	if err != nil {
+		logger.Fatalf("Error creating table OrderEvent: %v", err)
+	}
 }
 
 func (a *Application) alterTables() {
@@ -142,4 +204,14 @@ func (a *Application) index() {
 		QuoteCurrency(50), 
 		QuoteIssuer(50)
 	)`)
+	// Supports the cursor based pagination (ORDER BY Sequence)
+	a.client.Client.Exec(`CREATE INDEX orderdata_6 ON OrderData (
+		Account,
+		Network,
+		Sequence
+	)`)
+	// Supports the pruning of the snapshots past the retention
+	a.client.Client.Exec(`CREATE INDEX orderbooksnapshot_1 ON OrderBookSnapshot (
+		BlockTimeSeconds
+	)`)
 }
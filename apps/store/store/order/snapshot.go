@@ -0,0 +1,234 @@
+package order
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ordergrpc "github.com/CoreumFoundation/CoreDEX-API/domain/order"
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+const (
+	// maxSeriesIntervals is the maximum number of intervals returned by GetOrderBookSnapshotSeries
+	maxSeriesIntervals = 10000
+	// SNAPSHOT_RETENTION is how long the order book snapshots are kept, older snapshots are pruned
+	SNAPSHOT_RETENTION = 90 * 24 * time.Hour
+	// snapshotPruneInterval is the interval in which the snapshots past the retention are pruned
+	snapshotPruneInterval = time.Hour
+	// snapshotPruneBatch is the number of snapshots deleted per statement, to not lock the table for long
+	snapshotPruneBatch = 10000
+)
+
+// pruneSnapshots deletes the order book snapshots past the retention, every snapshotPruneInterval.
+// Runs in every store replica: The deletes are idempotent.
+func (a *Application) pruneSnapshots() {
+	for {
+		cutoff := time.Now().Add(-SNAPSHOT_RETENTION).Unix()
+		pruned := int64(0)
+		for {
+			res, err := a.client.Client.Exec(`DELETE FROM OrderBookSnapshot WHERE BlockTimeSeconds < ? LIMIT ?`, cutoff, snapshotPruneBatch)
+			if err != nil {
+				logger.Errorf("Error pruning order book snapshots before %d: %v", cutoff, err)
+				break
+			}
+			n, err := res.RowsAffected()
+			if err != nil {
+				logger.Errorf("Error pruning order book snapshots before %d: %v", cutoff, err)
+				break
+			}
+			pruned += n
+			if n < snapshotPruneBatch {
+				break
+			}
+		}
+		if pruned > 0 {
+			logger.Infof("Pruned %d order book snapshots before %d", pruned, cutoff)
+		}
+		time.Sleep(snapshotPruneInterval)
+	}
+}
+
+func (a *Application) UpsertOrderBookSnapshot(in *ordergrpc.OrderBookSnapshot) error {
+	if in.Buy == nil {
+		in.Buy = make([]*ordergrpc.OrderBookOrder, 0)
+	}
+	if in.Sell == nil {
+		in.Sell = make([]*ordergrpc.OrderBookOrder, 0)
+	}
+	buy, err := json.Marshal(in.Buy)
+	if err != nil {
+		logger.Errorf("Error marshalling buy orders for order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	sell, err := json.Marshal(in.Sell)
+	if err != nil {
+		logger.Errorf("Error marshalling sell orders for order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	blockTime, err := json.Marshal(in.BlockTime)
+	if err != nil {
+		logger.Errorf("Error marshalling blockTime for order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	metrics, err := json.Marshal(in.Metrics)
+	if err != nil {
+		logger.Errorf("Error marshalling metrics for order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	if in.MetaData.CreatedAt == nil {
+		in.MetaData.CreatedAt = timestamppb.Now()
+	}
+	in.MetaData.UpdatedAt = timestamppb.Now()
+	metaData, err := json.Marshal(in.MetaData)
+	if err != nil {
+		logger.Errorf("Error marshalling metadata for order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	_, err = a.client.Client.Exec(`INSERT INTO OrderBookSnapshot (BaseDenom, QuoteDenom, Buy, Sell, BlockHeight, BlockTime, Metrics, MetaData, Network)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE Buy=?,
+		Sell=?,
+		Metrics=?,
+		MetaData=?`,
+		in.BaseDenom,
+		in.QuoteDenom,
+		buy,
+		sell,
+		in.BlockHeight,
+		blockTime,
+		metrics,
+		metaData,
+		in.MetaData.Network,
+
+		buy,
+		sell,
+		metrics,
+		metaData)
+	if err != nil {
+		logger.Errorf("Error upserting order book snapshot %s/%s-%d: %v", in.BaseDenom, in.QuoteDenom, in.BlockHeight, err)
+		return err
+	}
+	return nil
+}
+
+func (a *Application) GetOrderBookSnapshot(in *ordergrpc.OrderBookSnapshotQuery) (*ordergrpc.OrderBookSnapshot, error) {
+	rows, err := a.client.Client.Query(`
+	SELECT BaseDenom, QuoteDenom, Buy, Sell, BlockHeight, BlockTime, Metrics, MetaData
+	FROM OrderBookSnapshot
+	WHERE
+		Network=?
+		AND BaseDenom=?
+		AND QuoteDenom=?
+		AND BlockTimeSeconds <= ?
+	ORDER BY BlockTimeSeconds DESC, BlockHeight DESC
+	LIMIT 1`,
+		in.Network,
+		in.BaseDenom,
+		in.QuoteDenom,
+		in.At.AsTime().Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, status.Errorf(codes.NotFound, "no order book snapshot found for %s/%s at %d, Network=%d", in.BaseDenom, in.QuoteDenom, in.At.AsTime().Unix(), in.Network)
+	}
+	snapshot := &ordergrpc.OrderBookSnapshot{}
+	buy := make([]byte, 0)
+	sell := make([]byte, 0)
+	err = scanSnapshot(rows, snapshot, &buy, &sell)
+	if err != nil {
+		return nil, err
+	}
+	json.Unmarshal(buy, &snapshot.Buy)
+	json.Unmarshal(sell, &snapshot.Sell)
+	return snapshot, nil
+}
+
+func (a *Application) GetOrderBookSnapshotSeries(in *ordergrpc.OrderBookSnapshotSeriesFilter) (*ordergrpc.OrderBookSnapshots, error) {
+	if in.Interval <= 0 || in.From == nil || in.To == nil {
+		return nil, fmt.Errorf("interval, from and to are required")
+	}
+	from := in.From.AsTime().Unix()
+	to := in.To.AsTime().Unix()
+	if (to-from)/in.Interval > maxSeriesIntervals {
+		return nil, fmt.Errorf("more than %d intervals requested", maxSeriesIntervals)
+	}
+	// The last snapshot (highest block) of each interval, the orders are not retrieved
+	rows, err := a.client.Client.Query(`
+	SELECT s.BaseDenom, s.QuoteDenom, NULL, NULL, s.BlockHeight, s.BlockTime, s.Metrics, s.MetaData
+	FROM OrderBookSnapshot s
+	JOIN (
+		SELECT MAX(BlockHeight) AS BlockHeight
+		FROM OrderBookSnapshot
+		WHERE
+			Network=?
+			AND BaseDenom=?
+			AND QuoteDenom=?
+			AND BlockTimeSeconds >= ?
+			AND BlockTimeSeconds < ?
+		GROUP BY FLOOR(BlockTimeSeconds / ?)
+	) l ON s.BlockHeight = l.BlockHeight
+	WHERE
+		s.Network=?
+		AND s.BaseDenom=?
+		AND s.QuoteDenom=?
+	ORDER BY s.BlockHeight`,
+		in.Network,
+		in.BaseDenom,
+		in.QuoteDenom,
+		from,
+		to,
+		in.Interval,
+		in.Network,
+		in.BaseDenom,
+		in.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := &ordergrpc.OrderBookSnapshots{Snapshots: make([]*ordergrpc.OrderBookSnapshot, 0)}
+	for rows.Next() {
+		snapshot := &ordergrpc.OrderBookSnapshot{}
+		var buy, sell []byte
+		if err := scanSnapshot(rows, snapshot, &buy, &sell); err != nil {
+			return nil, err
+		}
+		res.Snapshots = append(res.Snapshots, snapshot)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func scanSnapshot(rows *sql.Rows, snapshot *ordergrpc.OrderBookSnapshot, buy, sell *[]byte) error {
+	blockTime := make([]byte, 0)
+	metrics := make([]byte, 0)
+	metaData := make([]byte, 0)
+	err := rows.Scan(
+		&snapshot.BaseDenom,
+		&snapshot.QuoteDenom,
+		buy,
+		sell,
+		&snapshot.BlockHeight,
+		&blockTime,
+		&metrics,
+		&metaData,
+	)
+	if err != nil {
+		return err
+	}
+	json.Unmarshal(blockTime, &snapshot.BlockTime)
+	json.Unmarshal(metrics, &snapshot.Metrics)
+	json.Unmarshal(metaData, &snapshot.MetaData)
+	return nil
+}
@@ -142,6 +142,20 @@ func (a *Application) index() {
 		BlockTimeSeconds,
 		Network
 	)`)
+	// Indexes supporting the cursor based pagination (ORDER BY BlockHeight, Sequence)
+	a.client.Client.Exec(`CREATE INDEX trade_4 ON Trade (
+		Network,
+		Symbol1,
+		Symbol2,
+		BlockHeight,
+		Sequence
+	)`)
+	a.client.Client.Exec(`CREATE INDEX trade_5 ON Trade (
+		Account,
+		Network,
+		BlockHeight,
+		Sequence
+	)`)
 	a.client.Client.Exec(`CREATE INDEX tradepairs_3 ON TradePairs (
 		Network,
 		Currency1,
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/CoreumFoundation/CoreDEX-API/domain/cursor"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/denom"
 	"github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	tradegrpc "github.com/CoreumFoundation/CoreDEX-API/domain/trade"
@@ -39,6 +40,10 @@ Denom2,
 MetaData,
 PriceTick,
 QuantityStep `
+
+	// Default and maximum page size for GetAll
+	defaultLimit = 50
+	maxLimit     = 1000
 )
 
 type Application struct {
@@ -270,9 +275,30 @@ func (a *Application) GetAll(filter *tradegrpc.Filter) (*tradegrpc.Trades, error
 		queryBuilder.WriteString(" AND Side = ?")
 		args = append(args, *filter.Side)
 	}
-	queryBuilder.WriteString(" ORDER BY BlockTimeSeconds DESC")
-	if filter.From == nil || filter.From.AsTime().Unix() == 0 {
-		queryBuilder.WriteString(" LIMIT 50")
+	if filter.Cursor != nil && *filter.Cursor != "" {
+		c, err := cursor.Decode(*filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		// Continue after the last returned trade (same sort order as below)
+		queryBuilder.WriteString(" AND (BlockHeight < ? OR (BlockHeight = ? AND (Sequence < ? OR (Sequence = ? AND TXID < ?))))")
+		args = append(args, c.BlockHeight, c.BlockHeight, c.Sequence, c.Sequence, c.TXID)
+	}
+	// Sort on a unique key so that the cursor is stable
+	queryBuilder.WriteString(" ORDER BY BlockHeight DESC, Sequence DESC, TXID DESC")
+	limit := 0
+	if filter.Limit != nil && *filter.Limit > 0 {
+		limit = int(*filter.Limit)
+	} else if filter.From == nil || filter.From.AsTime().Unix() == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if limit > 0 {
+		// Retrieve one more than requested to determine if there is a next page
+		queryBuilder.WriteString(" LIMIT ?")
+		args = append(args, limit+1)
 	}
 
 	rows, err := a.client.Client.Query(queryBuilder.String(), args...)
@@ -296,7 +322,14 @@ func (a *Application) GetAll(filter *tradegrpc.Filter) (*tradegrpc.Trades, error
 		return nil, err
 	}
 
-	return &tradegrpc.Trades{Trades: trades}, nil
+	res := &tradegrpc.Trades{Trades: trades}
+	if limit > 0 && len(trades) > limit {
+		res.Trades = trades[:limit]
+		last := res.Trades[limit-1]
+		next := (&cursor.Cursor{BlockHeight: last.BlockHeight, Sequence: last.Sequence, TXID: last.GetTXID()}).Encode()
+		res.NextCursor = &next
+	}
+	return res, nil
 }
 
 func mapToTrade(b *sql.Rows) (*tradegrpc.Trade, error) {
@@ -77,6 +77,14 @@ export interface Order {
     BlockHeight: number;
     /** If the order has been enriched with precision data */
     Enriched: boolean;
+    /**
+     * The transaction and block which triggered this update of the order (placement, match, cancellation or expiry).
+     * Not stored with the order: The store records them with the lifecycle event of the update (OrderEvent).
+     * The TXID, BlockHeight and BlockTime of the order are used when not set.
+     */
+    EventTXID: string;
+    EventBlockHeight: number;
+    EventBlockTime: Date | undefined;
 }
 /** GoodTil is a good til order settings. */
 export interface GoodTil {
@@ -88,6 +96,8 @@ export interface GoodTil {
 export interface Orders {
     Orders: Order[];
     Offset?: number | undefined;
+    /** Opaque cursor to retrieve the next page (not set if there are no more results) */
+    NextCursor?: string | undefined;
 }
 export declare const Order: {
     encode(message: Order, writer?: _m0.Writer): _m0.Writer;
@@ -145,6 +155,9 @@ export declare const Order: {
         TXID?: string | undefined;
         BlockHeight?: number | undefined;
         Enriched?: boolean | undefined;
+        EventTXID?: string | undefined;
+        EventBlockHeight?: number | undefined;
+        EventBlockTime?: Date | undefined;
     } & {
         Account?: string | undefined;
         Type?: OrderType | undefined;
@@ -227,6 +240,9 @@ export declare const Order: {
         TXID?: string | undefined;
         BlockHeight?: number | undefined;
         Enriched?: boolean | undefined;
+        EventTXID?: string | undefined;
+        EventBlockHeight?: number | undefined;
+        EventBlockTime?: Date | undefined;
     } & { [K_6 in Exclude<keyof I, keyof Order>]: never; }>(base?: I | undefined): Order;
     fromPartial<I_1 extends {
         Account?: string | undefined;
@@ -279,6 +295,9 @@ export declare const Order: {
         TXID?: string | undefined;
         BlockHeight?: number | undefined;
         Enriched?: boolean | undefined;
+        EventTXID?: string | undefined;
+        EventBlockHeight?: number | undefined;
+        EventBlockTime?: Date | undefined;
     } & {
         Account?: string | undefined;
         Type?: OrderType | undefined;
@@ -361,6 +380,9 @@ export declare const Order: {
         TXID?: string | undefined;
         BlockHeight?: number | undefined;
         Enriched?: boolean | undefined;
+        EventTXID?: string | undefined;
+        EventBlockHeight?: number | undefined;
+        EventBlockTime?: Date | undefined;
     } & { [K_13 in Exclude<keyof I_1, keyof Order>]: never; }>(object: I_1): Order;
 };
 export declare const GoodTil: {
@@ -440,8 +462,12 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[] | undefined;
         Offset?: number | undefined;
+        NextCursor?: string | undefined;
     } & {
         Orders?: ({
             Account?: string | undefined;
@@ -494,6 +520,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[] & ({
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -545,6 +574,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         } & {
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -627,6 +659,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         } & { [K_6 in Exclude<keyof I["Orders"][number], keyof Order>]: never; })[] & { [K_7 in Exclude<keyof I["Orders"], keyof {
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -678,8 +713,12 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[]>]: never; }) | undefined;
         Offset?: number | undefined;
+        NextCursor?: string | undefined;
     } & { [K_8 in Exclude<keyof I, keyof Orders>]: never; }>(base?: I | undefined): Orders;
     fromPartial<I_1 extends {
         Orders?: {
@@ -733,8 +772,12 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[] | undefined;
         Offset?: number | undefined;
+        NextCursor?: string | undefined;
     } & {
         Orders?: ({
             Account?: string | undefined;
@@ -787,6 +830,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[] & ({
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -838,6 +884,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         } & {
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -920,6 +969,9 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         } & { [K_15 in Exclude<keyof I_1["Orders"][number], keyof Order>]: never; })[] & { [K_16 in Exclude<keyof I_1["Orders"], keyof {
             Account?: string | undefined;
             Type?: OrderType | undefined;
@@ -971,8 +1023,12 @@ export declare const Orders: {
             TXID?: string | undefined;
             BlockHeight?: number | undefined;
             Enriched?: boolean | undefined;
+            EventTXID?: string | undefined;
+            EventBlockHeight?: number | undefined;
+            EventBlockTime?: Date | undefined;
         }[]>]: never; }) | undefined;
         Offset?: number | undefined;
+        NextCursor?: string | undefined;
     } & { [K_17 in Exclude<keyof I_1, keyof Orders>]: never; }>(object: I_1): Orders;
 };
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
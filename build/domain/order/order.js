@@ -179,6 +179,9 @@ function createBaseOrder() {
         TXID: undefined,
         BlockHeight: 0,
         Enriched: false,
+        EventTXID: "",
+        EventBlockHeight: 0,
+        EventBlockTime: undefined,
     };
 }
 export const Order = {
@@ -240,6 +243,15 @@ export const Order = {
         if (message.Enriched !== false) {
             writer.uint32(184).bool(message.Enriched);
         }
+        if (message.EventTXID !== "") {
+            writer.uint32(194).string(message.EventTXID);
+        }
+        if (message.EventBlockHeight !== 0) {
+            writer.uint32(200).int64(message.EventBlockHeight);
+        }
+        if (message.EventBlockTime !== undefined) {
+            Timestamp.encode(toTimestamp(message.EventBlockTime), writer.uint32(210).fork()).ldelim();
+        }
         return writer;
     },
     decode(input, length) {
@@ -363,6 +375,24 @@ export const Order = {
                     }
                     message.Enriched = reader.bool();
                     continue;
+                case 24:
+                    if (tag !== 194) {
+                        break;
+                    }
+                    message.EventTXID = reader.string();
+                    continue;
+                case 25:
+                    if (tag !== 200) {
+                        break;
+                    }
+                    message.EventBlockHeight = longToNumber(reader.int64());
+                    continue;
+                case 26:
+                    if (tag !== 210) {
+                        break;
+                    }
+                    message.EventBlockTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+                    continue;
             }
             if ((tag & 7) === 4 || tag === 0) {
                 break;
@@ -392,6 +422,9 @@ export const Order = {
             TXID: isSet(object.TXID) ? globalThis.String(object.TXID) : undefined,
             BlockHeight: isSet(object.BlockHeight) ? globalThis.Number(object.BlockHeight) : 0,
             Enriched: isSet(object.Enriched) ? globalThis.Boolean(object.Enriched) : false,
+            EventTXID: isSet(object.EventTXID) ? globalThis.String(object.EventTXID) : "",
+            EventBlockHeight: isSet(object.EventBlockHeight) ? globalThis.Number(object.EventBlockHeight) : 0,
+            EventBlockTime: isSet(object.EventBlockTime) ? fromJsonTimestamp(object.EventBlockTime) : undefined,
         };
     },
     toJSON(message) {
@@ -453,13 +486,22 @@ export const Order = {
         if (message.Enriched !== false) {
             obj.Enriched = message.Enriched;
         }
+        if (message.EventTXID !== "") {
+            obj.EventTXID = message.EventTXID;
+        }
+        if (message.EventBlockHeight !== 0) {
+            obj.EventBlockHeight = Math.round(message.EventBlockHeight);
+        }
+        if (message.EventBlockTime !== undefined) {
+            obj.EventBlockTime = message.EventBlockTime.toISOString();
+        }
         return obj;
     },
     create(base) {
         return Order.fromPartial(base !== null && base !== void 0 ? base : {});
     },
     fromPartial(object) {
-        var _a, _b, _c, _d, _e, _f, _g, _h, _j, _k, _l, _m, _o;
+        var _a, _b, _c, _d, _e, _f, _g, _h, _j, _k, _l, _m, _o, _p, _q, _r;
         const message = createBaseOrder();
         message.Account = (_a = object.Account) !== null && _a !== void 0 ? _a : "";
         message.Type = (_b = object.Type) !== null && _b !== void 0 ? _b : 0;
@@ -492,6 +534,9 @@ export const Order = {
         message.TXID = (_l = object.TXID) !== null && _l !== void 0 ? _l : undefined;
         message.BlockHeight = (_m = object.BlockHeight) !== null && _m !== void 0 ? _m : 0;
         message.Enriched = (_o = object.Enriched) !== null && _o !== void 0 ? _o : false;
+        message.EventTXID = (_p = object.EventTXID) !== null && _p !== void 0 ? _p : "";
+        message.EventBlockHeight = (_q = object.EventBlockHeight) !== null && _q !== void 0 ? _q : 0;
+        message.EventBlockTime = (_r = object.EventBlockTime) !== null && _r !== void 0 ? _r : undefined;
         return message;
     },
 };
@@ -563,7 +608,7 @@ export const GoodTil = {
     },
 };
 function createBaseOrders() {
-    return { Orders: [], Offset: undefined };
+    return { Orders: [], Offset: undefined, NextCursor: undefined };
 }
 export const Orders = {
     encode(message, writer = _m0.Writer.create()) {
@@ -573,6 +618,9 @@ export const Orders = {
         if (message.Offset !== undefined) {
             writer.uint32(16).int32(message.Offset);
         }
+        if (message.NextCursor !== undefined) {
+            writer.uint32(26).string(message.NextCursor);
+        }
         return writer;
     },
     decode(input, length) {
@@ -594,6 +642,12 @@ export const Orders = {
                     }
                     message.Offset = reader.int32();
                     continue;
+                case 3:
+                    if (tag !== 26) {
+                        break;
+                    }
+                    message.NextCursor = reader.string();
+                    continue;
             }
             if ((tag & 7) === 4 || tag === 0) {
                 break;
@@ -606,6 +660,7 @@ export const Orders = {
         return {
             Orders: globalThis.Array.isArray(object === null || object === void 0 ? void 0 : object.Orders) ? object.Orders.map((e) => Order.fromJSON(e)) : [],
             Offset: isSet(object.Offset) ? globalThis.Number(object.Offset) : undefined,
+            NextCursor: isSet(object.NextCursor) ? globalThis.String(object.NextCursor) : undefined,
         };
     },
     toJSON(message) {
@@ -617,16 +672,20 @@ export const Orders = {
         if (message.Offset !== undefined) {
             obj.Offset = Math.round(message.Offset);
         }
+        if (message.NextCursor !== undefined) {
+            obj.NextCursor = message.NextCursor;
+        }
         return obj;
     },
     create(base) {
         return Orders.fromPartial(base !== null && base !== void 0 ? base : {});
     },
     fromPartial(object) {
-        var _a, _b;
+        var _a, _b, _c;
         const message = createBaseOrders();
         message.Orders = ((_a = object.Orders) === null || _a === void 0 ? void 0 : _a.map((e) => Order.fromPartial(e))) || [];
         message.Offset = (_b = object.Offset) !== null && _b !== void 0 ? _b : undefined;
+        message.NextCursor = (_c = object.NextCursor) !== null && _c !== void 0 ? _c : undefined;
         return message;
     },
 };
@@ -38,6 +38,8 @@ export interface Trade {
 }
 export interface Trades {
     Trades: Trade[];
+    /** Opaque cursor to retrieve the next page (not set if there are no more results) */
+    NextCursor?: string | undefined;
 }
 export interface TradePair {
     Denom1: Denom | undefined;
@@ -325,6 +327,7 @@ export declare const Trades: {
             USD?: number | undefined;
             Inverted?: boolean | undefined;
         }[] | undefined;
+        NextCursor?: string | undefined;
     } & {
         Trades?: ({
             Account?: string | undefined;
@@ -520,7 +523,8 @@ export declare const Trades: {
             USD?: number | undefined;
             Inverted?: boolean | undefined;
         }[]>]: never; }) | undefined;
-    } & { [K_6 in Exclude<keyof I, "Trades">]: never; }>(base?: I | undefined): Trades;
+        NextCursor?: string | undefined;
+    } & { [K_6 in Exclude<keyof I, keyof Trades>]: never; }>(base?: I | undefined): Trades;
     fromPartial<I_1 extends {
         Trades?: {
             Account?: string | undefined;
@@ -565,6 +569,7 @@ export declare const Trades: {
             USD?: number | undefined;
             Inverted?: boolean | undefined;
         }[] | undefined;
+        NextCursor?: string | undefined;
     } & {
         Trades?: ({
             Account?: string | undefined;
@@ -760,7 +765,8 @@ export declare const Trades: {
             USD?: number | undefined;
             Inverted?: boolean | undefined;
         }[]>]: never; }) | undefined;
-    } & { [K_13 in Exclude<keyof I_1, "Trades">]: never; }>(object: I_1): Trades;
+        NextCursor?: string | undefined;
+    } & { [K_13 in Exclude<keyof I_1, keyof Trades>]: never; }>(object: I_1): Trades;
 };
 export declare const TradePair: {
     encode(message: TradePair, writer?: _m0.Writer): _m0.Writer;
@@ -301,13 +301,16 @@ export const Trade = {
     },
 };
 function createBaseTrades() {
-    return { Trades: [] };
+    return { Trades: [], NextCursor: undefined };
 }
 export const Trades = {
     encode(message, writer = _m0.Writer.create()) {
         for (const v of message.Trades) {
             Trade.encode(v, writer.uint32(10).fork()).ldelim();
         }
+        if (message.NextCursor !== undefined) {
+            writer.uint32(18).string(message.NextCursor);
+        }
         return writer;
     },
     decode(input, length) {
@@ -323,6 +326,12 @@ export const Trades = {
                     }
                     message.Trades.push(Trade.decode(reader, reader.uint32()));
                     continue;
+                case 2:
+                    if (tag !== 18) {
+                        break;
+                    }
+                    message.NextCursor = reader.string();
+                    continue;
             }
             if ((tag & 7) === 4 || tag === 0) {
                 break;
@@ -332,7 +341,10 @@ export const Trades = {
         return message;
     },
     fromJSON(object) {
-        return { Trades: globalThis.Array.isArray(object === null || object === void 0 ? void 0 : object.Trades) ? object.Trades.map((e) => Trade.fromJSON(e)) : [] };
+        return {
+            Trades: globalThis.Array.isArray(object === null || object === void 0 ? void 0 : object.Trades) ? object.Trades.map((e) => Trade.fromJSON(e)) : [],
+            NextCursor: isSet(object.NextCursor) ? globalThis.String(object.NextCursor) : undefined,
+        };
     },
     toJSON(message) {
         var _a;
@@ -340,15 +352,19 @@ export const Trades = {
         if ((_a = message.Trades) === null || _a === void 0 ? void 0 : _a.length) {
             obj.Trades = message.Trades.map((e) => Trade.toJSON(e));
         }
+        if (message.NextCursor !== undefined) {
+            obj.NextCursor = message.NextCursor;
+        }
         return obj;
     },
     create(base) {
         return Trades.fromPartial(base !== null && base !== void 0 ? base : {});
     },
     fromPartial(object) {
-        var _a;
+        var _a, _b;
         const message = createBaseTrades();
         message.Trades = ((_a = object.Trades) === null || _a === void 0 ? void 0 : _a.map((e) => Trade.fromPartial(e))) || [];
+        message.NextCursor = (_b = object.NextCursor) !== null && _b !== void 0 ? _b : undefined;
         return message;
     },
 };
@@ -1,11 +1,24 @@
 import _m0 from "protobufjs/minimal";
 import { Network } from "../metadata/metadata";
 export declare const protobufPackage = "update";
+export declare enum UpdateType {
+    /** SNAPSHOT - The complete content of the channel */
+    SNAPSHOT = 0,
+    /** DELTA - The changes since the previous message (see the README for the content per method) */
+    DELTA = 1,
+    UNRECOGNIZED = -1
+}
+export declare function updateTypeFromJSON(object: any): UpdateType;
+export declare function updateTypeToJSON(object: UpdateType): string;
 export declare enum Action {
     SUBSCRIBE = 0,
     UNSUBSCRIBE = 1,
     CLOSE = 2,
     RESPONSE = 3,
+    /** ACK - The subscribe or unsubscribe of the Subscription succeeded */
+    ACK = 4,
+    /** ERROR - The request failed, see Error */
+    ERROR = 5,
     UNRECOGNIZED = -1
 }
 export declare function actionFromJSON(object: any): Action;
@@ -28,6 +41,14 @@ export declare enum Method {
     ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT = 7,
     /** WALLET - ID: {account} */
     WALLET = 8,
+    /** TX_STATUS - ID: {txhash} */
+    TX_STATUS = 9,
+    /** ORDERBOOKS_FOR_ACCOUNT - ID: {account} */
+    ORDERBOOKS_FOR_ACCOUNT = 10,
+    /** DEPTH - ID: {denom1}_{denom2}_{grouping} */
+    DEPTH = 11,
+    /** ORDERS_FOR_ACCOUNT - ID: {account} or {account}_{denom1}_{denom2} */
+    ORDERS_FOR_ACCOUNT = 12,
     UNRECOGNIZED = -1
 }
 export declare function methodFromJSON(object: any): Method;
@@ -35,12 +56,20 @@ export declare function methodToJSON(object: Method): string;
 export interface Subscribe {
     Action: Action;
     Subscription: Subscription | undefined;
+    /** Reason of an ERROR */
+    Error: string;
 }
 export interface Subscription {
     Method: Method;
     ID: string;
     Network: Network;
     Content: string;
+    /**
+     * Sequence of the message in the channel (Network, Method and ID), increases by 1 with every message.
+     * A gap means a message was missed: Resubscribe to receive a new snapshot.
+     */
+    Sequence: number;
+    Type: UpdateType;
 }
 export declare const Subscribe: {
     encode(message: Subscribe, writer?: _m0.Writer): _m0.Writer;
@@ -54,7 +83,10 @@ export declare const Subscribe: {
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } | undefined;
+        Error?: string | undefined;
     } & {
         Action?: Action | undefined;
         Subscription?: ({
@@ -62,12 +94,17 @@ export declare const Subscribe: {
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } & {
             Method?: Method | undefined;
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } & { [K in Exclude<keyof I["Subscription"], keyof Subscription>]: never; }) | undefined;
+        Error?: string | undefined;
     } & { [K_1 in Exclude<keyof I, keyof Subscribe>]: never; }>(base?: I | undefined): Subscribe;
     fromPartial<I_1 extends {
         Action?: Action | undefined;
@@ -76,7 +113,10 @@ export declare const Subscribe: {
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } | undefined;
+        Error?: string | undefined;
     } & {
         Action?: Action | undefined;
         Subscription?: ({
@@ -84,12 +124,17 @@ export declare const Subscribe: {
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } & {
             Method?: Method | undefined;
             ID?: string | undefined;
             Network?: Network | undefined;
             Content?: string | undefined;
+            Sequence?: number | undefined;
+            Type?: UpdateType | undefined;
         } & { [K_2 in Exclude<keyof I_1["Subscription"], keyof Subscription>]: never; }) | undefined;
+        Error?: string | undefined;
     } & { [K_3 in Exclude<keyof I_1, keyof Subscribe>]: never; }>(object: I_1): Subscribe;
 };
 export declare const Subscription: {
@@ -102,22 +147,30 @@ export declare const Subscription: {
         ID?: string | undefined;
         Network?: Network | undefined;
         Content?: string | undefined;
+        Sequence?: number | undefined;
+        Type?: UpdateType | undefined;
     } & {
         Method?: Method | undefined;
         ID?: string | undefined;
         Network?: Network | undefined;
         Content?: string | undefined;
+        Sequence?: number | undefined;
+        Type?: UpdateType | undefined;
     } & { [K in Exclude<keyof I, keyof Subscription>]: never; }>(base?: I | undefined): Subscription;
     fromPartial<I_1 extends {
         Method?: Method | undefined;
         ID?: string | undefined;
         Network?: Network | undefined;
         Content?: string | undefined;
+        Sequence?: number | undefined;
+        Type?: UpdateType | undefined;
     } & {
         Method?: Method | undefined;
         ID?: string | undefined;
         Network?: Network | undefined;
         Content?: string | undefined;
+        Sequence?: number | undefined;
+        Type?: UpdateType | undefined;
     } & { [K_1 in Exclude<keyof I_1, keyof Subscription>]: never; }>(object: I_1): Subscription;
 };
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
@@ -4,15 +4,53 @@
 //   protoc               v5.29.3
 // source: domain/update/update.proto
 /* eslint-disable */
+import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { networkFromJSON, networkToJSON } from "../metadata/metadata";
 export const protobufPackage = "update";
+export var UpdateType;
+(function (UpdateType) {
+    /** SNAPSHOT - The complete content of the channel */
+    UpdateType[UpdateType["SNAPSHOT"] = 0] = "SNAPSHOT";
+    /** DELTA - The changes since the previous message (see the README for the content per method) */
+    UpdateType[UpdateType["DELTA"] = 1] = "DELTA";
+    UpdateType[UpdateType["UNRECOGNIZED"] = -1] = "UNRECOGNIZED";
+})(UpdateType || (UpdateType = {}));
+export function updateTypeFromJSON(object) {
+    switch (object) {
+        case 0:
+        case "SNAPSHOT":
+            return UpdateType.SNAPSHOT;
+        case 1:
+        case "DELTA":
+            return UpdateType.DELTA;
+        case -1:
+        case "UNRECOGNIZED":
+        default:
+            return UpdateType.UNRECOGNIZED;
+    }
+}
+export function updateTypeToJSON(object) {
+    switch (object) {
+        case UpdateType.SNAPSHOT:
+            return "SNAPSHOT";
+        case UpdateType.DELTA:
+            return "DELTA";
+        case UpdateType.UNRECOGNIZED:
+        default:
+            return "UNRECOGNIZED";
+    }
+}
 export var Action;
 (function (Action) {
     Action[Action["SUBSCRIBE"] = 0] = "SUBSCRIBE";
     Action[Action["UNSUBSCRIBE"] = 1] = "UNSUBSCRIBE";
     Action[Action["CLOSE"] = 2] = "CLOSE";
     Action[Action["RESPONSE"] = 3] = "RESPONSE";
+    /** ACK - The subscribe or unsubscribe of the Subscription succeeded */
+    Action[Action["ACK"] = 4] = "ACK";
+    /** ERROR - The request failed, see Error */
+    Action[Action["ERROR"] = 5] = "ERROR";
     Action[Action["UNRECOGNIZED"] = -1] = "UNRECOGNIZED";
 })(Action || (Action = {}));
 export function actionFromJSON(object) {
@@ -29,6 +67,12 @@ export function actionFromJSON(object) {
         case 3:
         case "RESPONSE":
             return Action.RESPONSE;
+        case 4:
+        case "ACK":
+            return Action.ACK;
+        case 5:
+        case "ERROR":
+            return Action.ERROR;
         case -1:
         case "UNRECOGNIZED":
         default:
@@ -45,6 +89,10 @@ export function actionToJSON(object) {
             return "CLOSE";
         case Action.RESPONSE:
             return "RESPONSE";
+        case Action.ACK:
+            return "ACK";
+        case Action.ERROR:
+            return "ERROR";
         case Action.UNRECOGNIZED:
         default:
             return "UNRECOGNIZED";
@@ -69,6 +117,14 @@ export var Method;
     Method[Method["ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT"] = 7] = "ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT";
     /** WALLET - ID: {account} */
     Method[Method["WALLET"] = 8] = "WALLET";
+    /** TX_STATUS - ID: {txhash} */
+    Method[Method["TX_STATUS"] = 9] = "TX_STATUS";
+    /** ORDERBOOKS_FOR_ACCOUNT - ID: {account} */
+    Method[Method["ORDERBOOKS_FOR_ACCOUNT"] = 10] = "ORDERBOOKS_FOR_ACCOUNT";
+    /** DEPTH - ID: {denom1}_{denom2}_{grouping} */
+    Method[Method["DEPTH"] = 11] = "DEPTH";
+    /** ORDERS_FOR_ACCOUNT - ID: {account} or {account}_{denom1}_{denom2} */
+    Method[Method["ORDERS_FOR_ACCOUNT"] = 12] = "ORDERS_FOR_ACCOUNT";
     Method[Method["UNRECOGNIZED"] = -1] = "UNRECOGNIZED";
 })(Method || (Method = {}));
 export function methodFromJSON(object) {
@@ -100,6 +156,18 @@ export function methodFromJSON(object) {
         case 8:
         case "WALLET":
             return Method.WALLET;
+        case 9:
+        case "TX_STATUS":
+            return Method.TX_STATUS;
+        case 10:
+        case "ORDERBOOKS_FOR_ACCOUNT":
+            return Method.ORDERBOOKS_FOR_ACCOUNT;
+        case 11:
+        case "DEPTH":
+            return Method.DEPTH;
+        case 12:
+        case "ORDERS_FOR_ACCOUNT":
+            return Method.ORDERS_FOR_ACCOUNT;
         case -1:
         case "UNRECOGNIZED":
         default:
@@ -126,13 +194,21 @@ export function methodToJSON(object) {
             return "ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT";
         case Method.WALLET:
             return "WALLET";
+        case Method.TX_STATUS:
+            return "TX_STATUS";
+        case Method.ORDERBOOKS_FOR_ACCOUNT:
+            return "ORDERBOOKS_FOR_ACCOUNT";
+        case Method.DEPTH:
+            return "DEPTH";
+        case Method.ORDERS_FOR_ACCOUNT:
+            return "ORDERS_FOR_ACCOUNT";
         case Method.UNRECOGNIZED:
         default:
             return "UNRECOGNIZED";
     }
 }
 function createBaseSubscribe() {
-    return { Action: 0, Subscription: undefined };
+    return { Action: 0, Subscription: undefined, Error: "" };
 }
 export const Subscribe = {
     encode(message, writer = _m0.Writer.create()) {
@@ -142,6 +218,9 @@ export const Subscribe = {
         if (message.Subscription !== undefined) {
             Subscription.encode(message.Subscription, writer.uint32(18).fork()).ldelim();
         }
+        if (message.Error !== "") {
+            writer.uint32(26).string(message.Error);
+        }
         return writer;
     },
     decode(input, length) {
@@ -163,6 +242,12 @@ export const Subscribe = {
                     }
                     message.Subscription = Subscription.decode(reader, reader.uint32());
                     continue;
+                case 3:
+                    if (tag !== 26) {
+                        break;
+                    }
+                    message.Error = reader.string();
+                    continue;
             }
             if ((tag & 7) === 4 || tag === 0) {
                 break;
@@ -175,6 +260,7 @@ export const Subscribe = {
         return {
             Action: isSet(object.Action) ? actionFromJSON(object.Action) : 0,
             Subscription: isSet(object.Subscription) ? Subscription.fromJSON(object.Subscription) : undefined,
+            Error: isSet(object.Error) ? globalThis.String(object.Error) : "",
         };
     },
     toJSON(message) {
@@ -185,23 +271,27 @@ export const Subscribe = {
         if (message.Subscription !== undefined) {
             obj.Subscription = Subscription.toJSON(message.Subscription);
         }
+        if (message.Error !== "") {
+            obj.Error = message.Error;
+        }
         return obj;
     },
     create(base) {
         return Subscribe.fromPartial(base !== null && base !== void 0 ? base : {});
     },
     fromPartial(object) {
-        var _a;
+        var _a, _b;
         const message = createBaseSubscribe();
         message.Action = (_a = object.Action) !== null && _a !== void 0 ? _a : 0;
         message.Subscription = (object.Subscription !== undefined && object.Subscription !== null)
             ? Subscription.fromPartial(object.Subscription)
             : undefined;
+        message.Error = (_b = object.Error) !== null && _b !== void 0 ? _b : "";
         return message;
     },
 };
 function createBaseSubscription() {
-    return { Method: 0, ID: "", Network: 0, Content: "" };
+    return { Method: 0, ID: "", Network: 0, Content: "", Sequence: 0, Type: 0 };
 }
 export const Subscription = {
     encode(message, writer = _m0.Writer.create()) {
@@ -217,6 +307,12 @@ export const Subscription = {
         if (message.Content !== "") {
             writer.uint32(34).string(message.Content);
         }
+        if (message.Sequence !== 0) {
+            writer.uint32(40).int64(message.Sequence);
+        }
+        if (message.Type !== 0) {
+            writer.uint32(48).int32(message.Type);
+        }
         return writer;
     },
     decode(input, length) {
@@ -250,6 +346,18 @@ export const Subscription = {
                     }
                     message.Content = reader.string();
                     continue;
+                case 5:
+                    if (tag !== 40) {
+                        break;
+                    }
+                    message.Sequence = longToNumber(reader.int64());
+                    continue;
+                case 6:
+                    if (tag !== 48) {
+                        break;
+                    }
+                    message.Type = reader.int32();
+                    continue;
             }
             if ((tag & 7) === 4 || tag === 0) {
                 break;
@@ -264,6 +372,8 @@ export const Subscription = {
             ID: isSet(object.ID) ? globalThis.String(object.ID) : "",
             Network: isSet(object.Network) ? networkFromJSON(object.Network) : 0,
             Content: isSet(object.Content) ? globalThis.String(object.Content) : "",
+            Sequence: isSet(object.Sequence) ? globalThis.Number(object.Sequence) : 0,
+            Type: isSet(object.Type) ? updateTypeFromJSON(object.Type) : 0,
         };
     },
     toJSON(message) {
@@ -280,21 +390,42 @@ export const Subscription = {
         if (message.Content !== "") {
             obj.Content = message.Content;
         }
+        if (message.Sequence !== 0) {
+            obj.Sequence = Math.round(message.Sequence);
+        }
+        if (message.Type !== 0) {
+            obj.Type = updateTypeToJSON(message.Type);
+        }
         return obj;
     },
     create(base) {
         return Subscription.fromPartial(base !== null && base !== void 0 ? base : {});
     },
     fromPartial(object) {
-        var _a, _b, _c, _d;
+        var _a, _b, _c, _d, _e, _f;
         const message = createBaseSubscription();
         message.Method = (_a = object.Method) !== null && _a !== void 0 ? _a : 0;
         message.ID = (_b = object.ID) !== null && _b !== void 0 ? _b : "";
         message.Network = (_c = object.Network) !== null && _c !== void 0 ? _c : 0;
         message.Content = (_d = object.Content) !== null && _d !== void 0 ? _d : "";
+        message.Sequence = (_e = object.Sequence) !== null && _e !== void 0 ? _e : 0;
+        message.Type = (_f = object.Type) !== null && _f !== void 0 ? _f : 0;
         return message;
     },
 };
+function longToNumber(long) {
+    if (long.gt(globalThis.Number.MAX_SAFE_INTEGER)) {
+        throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+    }
+    if (long.lt(globalThis.Number.MIN_SAFE_INTEGER)) {
+        throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+    }
+    return long.toNumber();
+}
+if (_m0.util.Long !== Long) {
+    _m0.util.Long = Long;
+    _m0.configure();
+}
 function isSet(value) {
     return value !== null && value !== undefined;
 }
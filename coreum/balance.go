@@ -0,0 +1,32 @@
+package coreum
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v5/x/asset/ft/types"
+)
+
+// QueryFrozenBalances returns the frozen balances of the account over all denoms (all pages).
+func (r *Reader) QueryFrozenBalances(ctx context.Context, account string) (sdk.Coins, error) {
+	assetftClient := assetfttypes.NewQueryClient(nodeConnections[r.Network])
+	frozen := sdk.Coins{}
+	var paginationKey []byte
+	for {
+		res, err := assetftClient.FrozenBalances(ctx, &assetfttypes.QueryFrozenBalancesRequest{
+			Account:    account,
+			Pagination: &query.PageRequest{Key: paginationKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		frozen = frozen.Add(res.Balances...)
+		if res.Pagination == nil || res.Pagination.NextKey == nil {
+			break
+		}
+		paginationKey = res.Pagination.NextKey
+	}
+	return frozen, nil
+}
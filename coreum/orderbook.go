@@ -3,10 +3,13 @@ package coreum
 import (
 	"context"
 	"sort"
+	"strconv"
 	"sync"
 
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/shopspring/decimal"
+	grpcmetadata "google.golang.org/grpc/metadata"
 
 	dextypes "github.com/CoreumFoundation/coreum/v5/x/dex/types"
 )
@@ -26,6 +29,33 @@ func (r *Reader) QueryOrderBooks(
 	return res.OrderBooks, res.Pagination.NextKey, nil
 }
 
+// QueryAllOrderBooks returns all available order books (all pages of QueryOrderBooks).
+func (r *Reader) QueryAllOrderBooks(ctx context.Context) ([]dextypes.OrderBookData, error) {
+	orderBooks := make([]dextypes.OrderBookData, 0)
+	var paginationKey []byte
+	for {
+		data, nextPaginationKey, err := r.QueryOrderBooks(ctx, paginationKey)
+		if err != nil {
+			return nil, err
+		}
+		orderBooks = append(orderBooks, data...)
+		if nextPaginationKey == nil {
+			break
+		}
+		paginationKey = nextPaginationKey
+	}
+	return orderBooks, nil
+}
+
+// QueryOrderBookParams returns the price tick and quantity step of the order book.
+func (r *Reader) QueryOrderBookParams(ctx context.Context, baseDenom, quoteDenom string) (*dextypes.QueryOrderBookParamsResponse, error) {
+	dexClient := dextypes.NewQueryClient(nodeConnections[r.Network])
+	return dexClient.OrderBookParams(ctx, &dextypes.QueryOrderBookParamsRequest{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	})
+}
+
 // QueryOrderBookOrders returns orders inside an order book. the paginationKey should nil for the first page.
 // the nextPaginationKey will be nil if there are no more pages.
 func (r *Reader) QueryOrderBookOrders(
@@ -44,6 +74,71 @@ func (r *Reader) QueryOrderBookOrders(
 	return res.Orders, res.Pagination.NextKey, nil
 }
 
+// QueryAllOrderBookOrders returns all orders of one side of an order book (all pages of QueryOrderBookOrders).
+func (r *Reader) QueryAllOrderBookOrders(ctx context.Context, baseDenom, quoteDenom string, side dextypes.Side) ([]dextypes.Order, error) {
+	dexClient := dextypes.NewQueryClient(nodeConnections[r.Network])
+	orders := make([]dextypes.Order, 0)
+	var paginationKey []byte
+	for {
+		res, err := dexClient.OrderBookOrders(ctx, &dextypes.QueryOrderBookOrdersRequest{
+			BaseDenom:  baseDenom,
+			QuoteDenom: quoteDenom,
+			Side:       side,
+			Pagination: &query.PageRequest{Key: paginationKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, res.Orders...)
+		if res.Pagination == nil || res.Pagination.NextKey == nil {
+			break
+		}
+		paginationKey = res.Pagination.NextKey
+	}
+	return orders, nil
+}
+
+// AtHeight returns a context which makes the queries of the reader return the state at the given block height
+// instead of the latest state. Queries fail if the node has pruned the height.
+func AtHeight(ctx context.Context, height int64) context.Context {
+	return grpcmetadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+}
+
+// QueryOrder returns an open order of the creator by its ID.
+func (r *Reader) QueryOrder(ctx context.Context, creator, id string) (*dextypes.Order, error) {
+	dexClient := dextypes.NewQueryClient(nodeConnections[r.Network])
+	res, err := dexClient.Order(ctx, &dextypes.QueryOrderRequest{
+		Creator: creator,
+		Id:      id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res.Order, nil
+}
+
+// QueryOrdersByCreator returns all open orders of the creator (over all order books).
+func (r *Reader) QueryOrdersByCreator(ctx context.Context, creator string) ([]dextypes.Order, error) {
+	dexClient := dextypes.NewQueryClient(nodeConnections[r.Network])
+	orders := make([]dextypes.Order, 0)
+	var paginationKey []byte
+	for {
+		res, err := dexClient.Orders(ctx, &dextypes.QueryOrdersRequest{
+			Creator:    creator,
+			Pagination: &query.PageRequest{Key: paginationKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, res.Orders...)
+		if res.Pagination == nil || res.Pagination.NextKey == nil {
+			break
+		}
+		paginationKey = res.Pagination.NextKey
+	}
+	return orders, nil
+}
+
 type OrderBookOrder struct {
 	PriceDec              decimal.Decimal
 	Price                 string
@@ -73,46 +168,134 @@ func (r *Reader) QueryOrderBookBySide(ctx context.Context,
 		return nil, err
 	}
 	orders := make([]*OrderBookOrder, 0)
-	switch invert {
-	case false:
-		for _, order := range res {
-			price, err := decimal.NewFromString(order.Price.String())
-			if err != nil {
-				return nil, err
-			}
-			orders = append(orders, &OrderBookOrder{
-				PriceDec:        price,
-				Price:           price.String(),
-				Amount:          order.Quantity.String(),
-				Sequence:        order.Sequence,
-				Account:         order.Creator,
-				OrderID:         order.ID,
-				RemainingAmount: order.RemainingBaseQuantity.String(),
-			})
-		}
-	case true:
-		for _, order := range res {
-			orderPrice, err := decimal.NewFromString(order.Price.String())
-			if err != nil {
-				return nil, err
-			}
-			invPrice := decimal.NewFromInt(1).Div(orderPrice)
-			quantity := decimal.NewFromBigInt(order.Quantity.BigInt(), 0).Mul(orderPrice)
-			remainingQuantity := decimal.NewFromBigInt(order.RemainingBaseQuantity.BigInt(), 0).Mul(orderPrice)
-			orders = append(orders, &OrderBookOrder{
-				PriceDec:        invPrice,
-				Price:           invPrice.String(),
-				Amount:          quantity.String(),
-				Sequence:        order.Sequence,
-				Account:         order.Creator,
-				OrderID:         order.ID,
-				RemainingAmount: remainingQuantity.String(),
-			})
+	for _, order := range res {
+		o, err := newOrderBookOrder(order, invert)
+		if err != nil {
+			return nil, err
 		}
+		orders = append(orders, o)
 	}
 	return orders, nil
 }
 
+// newOrderBookOrder converts a chain order into the order book format.
+// Inverted orders are orders of the opposite order book (base and quote denom swapped), expressed in the denoms of this
+// order book.
+func newOrderBookOrder(order dextypes.Order, invert bool) (*OrderBookOrder, error) {
+	price, err := decimal.NewFromString(order.Price.String())
+	if err != nil {
+		return nil, err
+	}
+	if !invert {
+		return &OrderBookOrder{
+			PriceDec:        price,
+			Price:           price.String(),
+			Amount:          order.Quantity.String(),
+			Sequence:        order.Sequence,
+			Account:         order.Creator,
+			OrderID:         order.ID,
+			RemainingAmount: order.RemainingBaseQuantity.String(),
+		}, nil
+	}
+	invPrice := decimal.NewFromInt(1).Div(price)
+	quantity := decimal.NewFromBigInt(order.Quantity.BigInt(), 0).Mul(price)
+	remainingQuantity := decimal.NewFromBigInt(order.RemainingBaseQuantity.BigInt(), 0).Mul(price)
+	return &OrderBookOrder{
+		PriceDec:        invPrice,
+		Price:           invPrice.String(),
+		Amount:          quantity.String(),
+		Sequence:        order.Sequence,
+		Account:         order.Creator,
+		OrderID:         order.ID,
+		RemainingAmount: remainingQuantity.String(),
+	}, nil
+}
+
+// QueryOrderBookOrdersForCreator returns all open orders of the creator in the order book of denom1/denom2, including
+// the orders in the opposite order book (denom2/denom1) in the same way as QueryOrderBookRelevantOrders.
+func (r *Reader) QueryOrderBookOrdersForCreator(ctx context.Context, creator, denom1, denom2 string) (*OrderBookOrders, error) {
+	orders, err := r.QueryOrdersByCreator(ctx, creator)
+	if err != nil {
+		return nil, err
+	}
+	return NewOrderBookOrders(orders, denom1, denom2)
+}
+
+// NewOrderBookOrders converts the orders of the order book denom1/denom2 and of the opposite order book (denom2/denom1)
+// into the order book of denom1/denom2 in the same way as QueryOrderBookRelevantOrders. Orders of other order books
+// are ignored.
+func NewOrderBookOrders(orders []dextypes.Order, denom1, denom2 string) (*OrderBookOrders, error) {
+	orderBookOrders := &OrderBookOrders{
+		Buy:  make([]*OrderBookOrder, 0),
+		Sell: make([]*OrderBookOrder, 0),
+	}
+	for _, order := range orders {
+		var invert bool
+		switch {
+		case order.BaseDenom == denom1 && order.QuoteDenom == denom2:
+			invert = false
+		case order.BaseDenom == denom2 && order.QuoteDenom == denom1:
+			invert = true
+		default:
+			continue
+		}
+		o, err := newOrderBookOrder(order, invert)
+		if err != nil {
+			return nil, err
+		}
+		// A sell in the opposite order book is a buy in this order book (and vice versa)
+		if (order.Side == dextypes.SIDE_BUY) != invert {
+			orderBookOrders.Buy = append(orderBookOrders.Buy, o)
+		} else {
+			orderBookOrders.Sell = append(orderBookOrders.Sell, o)
+		}
+	}
+	sortOrderBookOrders(orderBookOrders)
+	return orderBookOrders, nil
+}
+
+// QueryOrderBooksForCreator returns all open orders of the creator over all order books, by order book
+// ({base denom}_{quote denom} of the orders, so without inverting the orders).
+func (r *Reader) QueryOrderBooksForCreator(ctx context.Context, creator string) (map[string]*OrderBookOrders, error) {
+	orders, err := r.QueryOrdersByCreator(ctx, creator)
+	if err != nil {
+		return nil, err
+	}
+	orderBooks := make(map[string]*OrderBookOrders)
+	for _, order := range orders {
+		symbol := order.BaseDenom + "_" + order.QuoteDenom
+		if _, ok := orderBooks[symbol]; !ok {
+			orderBooks[symbol] = &OrderBookOrders{
+				Buy:  make([]*OrderBookOrder, 0),
+				Sell: make([]*OrderBookOrder, 0),
+			}
+		}
+		o, err := newOrderBookOrder(order, false)
+		if err != nil {
+			return nil, err
+		}
+		if order.Side == dextypes.SIDE_BUY {
+			orderBooks[symbol].Buy = append(orderBooks[symbol].Buy, o)
+		} else {
+			orderBooks[symbol].Sell = append(orderBooks[symbol].Sell, o)
+		}
+	}
+	for _, orderBook := range orderBooks {
+		sortOrderBookOrders(orderBook)
+	}
+	return orderBooks, nil
+}
+
+// sortOrderBookOrders sorts the sells ascending and the buys descending by price (best price first)
+func sortOrderBookOrders(orderBookOrders *OrderBookOrders) {
+	sort.SliceStable(orderBookOrders.Sell, func(i, j int) bool {
+		return orderBookOrders.Sell[i].PriceDec.LessThan(orderBookOrders.Sell[j].PriceDec)
+	})
+	sort.SliceStable(orderBookOrders.Buy, func(i, j int) bool {
+		return orderBookOrders.Buy[i].PriceDec.GreaterThan(orderBookOrders.Buy[j].PriceDec)
+	})
+}
+
 // QueryOrderBookRelevantOrders returns orders inside an order book around the spread.
 func (r *Reader) QueryOrderBookRelevantOrders(ctx context.Context, denom1, denom2 string, limit uint64) (orders *OrderBookOrders, err error) {
 	orderBookOrders := &OrderBookOrders{
@@ -182,16 +365,10 @@ func (r *Reader) QueryOrderBookRelevantOrders(ctx context.Context, denom1, denom
 		return nil, queryError
 	}
 
-	sort.SliceStable(orderBookOrders.Sell, func(i, j int) bool {
-		return orderBookOrders.Sell[i].PriceDec.LessThan(orderBookOrders.Sell[j].PriceDec)
-	})
+	sortOrderBookOrders(orderBookOrders)
 	if uint64(len(orderBookOrders.Sell)) > limit {
 		orderBookOrders.Sell = orderBookOrders.Sell[0:limit]
 	}
-
-	sort.SliceStable(orderBookOrders.Buy, func(i, j int) bool {
-		return orderBookOrders.Buy[i].PriceDec.GreaterThan(orderBookOrders.Buy[j].PriceDec)
-	})
 	if uint64(len(orderBookOrders.Buy)) > limit {
 		orderBookOrders.Buy = orderBookOrders.Buy[0:limit]
 	}
@@ -0,0 +1,39 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks the position of the last returned record in a list sorted by BlockHeight and Sequence (descending).
+// TXID is only used as a tie breaker for trades (multiple trades can share the same order sequence)
+type Cursor struct {
+	BlockHeight int64  `json:"h"`
+	Sequence    int64  `json:"s"`
+	TXID        string `json:"t,omitempty"`
+	// Sequence of the last returned cancelled order in a list which merges the trades with the cancelled orders, the
+	// next page continues with the cancelled orders below it
+	Cancelled int64 `json:"c,omitempty"`
+}
+
+// Encode the cursor into an opaque, url safe, string
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Decode an opaque cursor string as returned by Encode
+func Decode(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := &Cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return c, nil
+}
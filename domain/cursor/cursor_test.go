@@ -0,0 +1,19 @@
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Cursor(t *testing.T) {
+	c := &Cursor{BlockHeight: 123456, Sequence: 42, TXID: "ABCDEF"}
+	d, err := Decode(c.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, c, d)
+
+	_, err = Decode("not a cursor!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = Decode("bm90IGpzb24")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
@@ -0,0 +1,68 @@
+package order
+
+import (
+	sdecimal "github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewOrderEvent returns the lifecycle event of the update of the order, nil if the update did not change the status
+// or the remaining quantity (e.g. a block which is processed again). previous is nil for a new order.
+func NewOrderEvent(previous, updated *Order) *OrderEvent {
+	eventType := OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+	switch {
+	case updated.OrderStatus == OrderStatus_ORDER_STATUS_OPEN && previous == nil:
+		eventType = OrderEventType_ORDER_EVENT_PLACED
+	case updated.OrderStatus == OrderStatus_ORDER_STATUS_OPEN:
+		if remaining(updated).LessThan(remaining(previous)) {
+			eventType = OrderEventType_ORDER_EVENT_PARTIALLY_FILLED
+		}
+	case previous != nil && previous.OrderStatus == updated.OrderStatus:
+	case updated.OrderStatus == OrderStatus_ORDER_STATUS_FILLED:
+		eventType = OrderEventType_ORDER_EVENT_FILLED
+	case updated.OrderStatus == OrderStatus_ORDER_STATUS_CANCELED:
+		eventType = OrderEventType_ORDER_EVENT_CANCELED
+	case updated.OrderStatus == OrderStatus_ORDER_STATUS_EXPIRED:
+		eventType = OrderEventType_ORDER_EVENT_EXPIRED
+	}
+	if eventType == OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED {
+		return nil
+	}
+	event := &OrderEvent{
+		Type:              eventType,
+		Account:           updated.Account,
+		Sequence:          updated.Sequence,
+		OrderID:           updated.OrderID,
+		BaseDenom:         updated.BaseDenom,
+		QuoteDenom:        updated.QuoteDenom,
+		Side:              updated.Side,
+		Price:             updated.Price,
+		Quantity:          updated.Quantity,
+		RemainingQuantity: updated.RemainingQuantity,
+		TXID:              updated.EventTXID,
+		BlockHeight:       updated.EventBlockHeight,
+		BlockTime:         updated.EventBlockTime,
+		MetaData:          updated.MetaData,
+	}
+	// Updates without the trigger (e.g. from before the trigger was sent) are attributed to the order itself
+	if event.TXID == "" && event.BlockHeight == 0 && updated.TXID != nil {
+		event.TXID = *updated.TXID
+	}
+	if event.BlockHeight == 0 {
+		event.BlockHeight = updated.BlockHeight
+	}
+	if event.BlockTime == nil {
+		event.BlockTime = updated.BlockTime
+	}
+	if event.BlockTime == nil {
+		event.BlockTime = timestamppb.Now()
+	}
+	return event
+}
+
+// remaining returns the remaining quantity of the order, zero if not set
+func remaining(o *Order) sdecimal.Decimal {
+	if o.RemainingQuantity == nil {
+		return sdecimal.Zero
+	}
+	return sdecimal.New(o.RemainingQuantity.Value, o.RemainingQuantity.Exp)
+}
@@ -0,0 +1,58 @@
+package order
+
+import (
+	"testing"
+
+	"github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
+)
+
+func Test_NewOrderEvent(t *testing.T) {
+	txID := "placed"
+	order := func(status OrderStatus, remaining int64) *Order {
+		return &Order{
+			Sequence:          1,
+			OrderStatus:       status,
+			Quantity:          &decimal.Decimal{Value: 100},
+			RemainingQuantity: &decimal.Decimal{Value: remaining},
+			TXID:              &txID,
+			BlockHeight:       10,
+		}
+	}
+	tests := []struct {
+		name     string
+		previous *Order
+		updated  *Order
+		expected OrderEventType
+	}{
+		{"placed", nil, order(OrderStatus_ORDER_STATUS_OPEN, 100), OrderEventType_ORDER_EVENT_PLACED},
+		{"partially filled", order(OrderStatus_ORDER_STATUS_OPEN, 100), order(OrderStatus_ORDER_STATUS_OPEN, 40), OrderEventType_ORDER_EVENT_PARTIALLY_FILLED},
+		{"unchanged", order(OrderStatus_ORDER_STATUS_OPEN, 40), order(OrderStatus_ORDER_STATUS_OPEN, 40), OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED},
+		{"filled", order(OrderStatus_ORDER_STATUS_OPEN, 40), order(OrderStatus_ORDER_STATUS_FILLED, 0), OrderEventType_ORDER_EVENT_FILLED},
+		{"filled again", order(OrderStatus_ORDER_STATUS_FILLED, 0), order(OrderStatus_ORDER_STATUS_FILLED, 0), OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED},
+		{"canceled", order(OrderStatus_ORDER_STATUS_OPEN, 40), order(OrderStatus_ORDER_STATUS_CANCELED, 40), OrderEventType_ORDER_EVENT_CANCELED},
+		{"expired", order(OrderStatus_ORDER_STATUS_OPEN, 40), order(OrderStatus_ORDER_STATUS_EXPIRED, 40), OrderEventType_ORDER_EVENT_EXPIRED},
+		{"filled on placement", nil, order(OrderStatus_ORDER_STATUS_FILLED, 0), OrderEventType_ORDER_EVENT_FILLED},
+	}
+	for _, tt := range tests {
+		event := NewOrderEvent(tt.previous, tt.updated)
+		switch {
+		case tt.expected == OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED && event != nil:
+			t.Errorf("%s: expected no event, got %s", tt.name, event.Type)
+		case tt.expected != OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED && (event == nil || event.Type != tt.expected):
+			t.Errorf("%s: expected %s, got %v", tt.name, tt.expected, event)
+		}
+	}
+
+	// The event carries the trigger of the update, the order itself without
+	updated := order(OrderStatus_ORDER_STATUS_OPEN, 40)
+	event := NewOrderEvent(order(OrderStatus_ORDER_STATUS_OPEN, 100), updated)
+	if event.TXID != "placed" || event.BlockHeight != 10 || event.RemainingQuantity.Value != 40 {
+		t.Errorf("Unexpected event without trigger %v", event)
+	}
+	updated.EventTXID = "matched"
+	updated.EventBlockHeight = 12
+	event = NewOrderEvent(order(OrderStatus_ORDER_STATUS_OPEN, 100), updated)
+	if event.TXID != "matched" || event.BlockHeight != 12 {
+		t.Errorf("Unexpected event with trigger %v", event)
+	}
+}
@@ -7,12 +7,15 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type MockOrderServiceClient struct {
-	seq int
-	db  map[string]*orderWrapper
+	seq        int
+	db         map[string]*orderWrapper
+	orderBooks map[string]*OrderBook
 }
 
 type orderWrapper struct {
@@ -22,7 +25,8 @@ type orderWrapper struct {
 
 func NewMockOrderServiceClient() OrderServiceClient {
 	return &MockOrderServiceClient{
-		db: make(map[string]*orderWrapper),
+		db:         make(map[string]*orderWrapper),
+		orderBooks: make(map[string]*OrderBook),
 	}
 }
 
@@ -47,7 +51,7 @@ func (c *MockOrderServiceClient) Get(ctx context.Context, in *ID, opts ...grpc.C
 	key := fmt.Sprintf("%d-%s", in.Sequence, in.Network.String())
 	order, exists := c.db[key]
 	if !exists {
-		return nil, errors.New("not found")
+		return nil, status.Error(codes.NotFound, "not found")
 	}
 	return order.order, nil
 }
@@ -72,3 +76,34 @@ func (c *MockOrderServiceClient) GetAll(ctx context.Context, in *Filter, opts ..
 func (c *MockOrderServiceClient) BatchUpsert(ctx context.Context, in *Orders, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	panic("not implemented")
 }
+
+func (c *MockOrderServiceClient) UpsertOrderBook(ctx context.Context, in *OrderBook, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	key := fmt.Sprintf("%s_%s-%s", in.BaseDenom, in.QuoteDenom, in.MetaData.Network.String())
+	c.orderBooks[key] = in
+	return &emptypb.Empty{}, nil
+}
+
+func (c *MockOrderServiceClient) GetOrderBook(ctx context.Context, in *OrderBookID, opts ...grpc.CallOption) (*OrderBook, error) {
+	key := fmt.Sprintf("%s_%s-%s", in.BaseDenom, in.QuoteDenom, in.Network.String())
+	orderBook, exists := c.orderBooks[key]
+	if !exists {
+		return nil, errors.New("not found")
+	}
+	return orderBook, nil
+}
+
+func (c *MockOrderServiceClient) UpsertOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshot, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	panic("not implemented")
+}
+
+func (c *MockOrderServiceClient) GetOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshotQuery, opts ...grpc.CallOption) (*OrderBookSnapshot, error) {
+	return nil, errors.New("not found")
+}
+
+func (c *MockOrderServiceClient) GetOrderBookSnapshotSeries(ctx context.Context, in *OrderBookSnapshotSeriesFilter, opts ...grpc.CallOption) (*OrderBookSnapshots, error) {
+	return &OrderBookSnapshots{}, nil
+}
+
+func (c *MockOrderServiceClient) GetOrderEvents(ctx context.Context, in *OrderEventFilter, opts ...grpc.CallOption) (*OrderEvents, error) {
+	return &OrderEvents{}, nil
+}
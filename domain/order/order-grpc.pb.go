@@ -7,6 +7,7 @@
 package order
 
 import (
+	decimal "github.com/CoreumFoundation/CoreDEX-API/domain/decimal"
 	denom "github.com/CoreumFoundation/CoreDEX-API/domain/denom"
 	metadata "github.com/CoreumFoundation/CoreDEX-API/domain/metadata"
 	order_properties "github.com/CoreumFoundation/CoreDEX-API/domain/order-properties"
@@ -26,6 +27,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OrderEventType int32
+
+const (
+	OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED OrderEventType = 0
+	OrderEventType_ORDER_EVENT_PLACED           OrderEventType = 1
+	OrderEventType_ORDER_EVENT_PARTIALLY_FILLED OrderEventType = 2
+	OrderEventType_ORDER_EVENT_FILLED           OrderEventType = 3
+	OrderEventType_ORDER_EVENT_CANCELED         OrderEventType = 4
+	OrderEventType_ORDER_EVENT_EXPIRED          OrderEventType = 5
+)
+
+// Enum value maps for OrderEventType.
+var (
+	OrderEventType_name = map[int32]string{
+		0: "ORDER_EVENT_TYPE_UNSPECIFIED",
+		1: "ORDER_EVENT_PLACED",
+		2: "ORDER_EVENT_PARTIALLY_FILLED",
+		3: "ORDER_EVENT_FILLED",
+		4: "ORDER_EVENT_CANCELED",
+		5: "ORDER_EVENT_EXPIRED",
+	}
+	OrderEventType_value = map[string]int32{
+		"ORDER_EVENT_TYPE_UNSPECIFIED": 0,
+		"ORDER_EVENT_PLACED":           1,
+		"ORDER_EVENT_PARTIALLY_FILLED": 2,
+		"ORDER_EVENT_FILLED":           3,
+		"ORDER_EVENT_CANCELED":         4,
+		"ORDER_EVENT_EXPIRED":          5,
+	}
+)
+
+func (x OrderEventType) Enum() *OrderEventType {
+	p := new(OrderEventType)
+	*p = x
+	return p
+}
+
+func (x OrderEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_order_order_grpc_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderEventType) Type() protoreflect.EnumType {
+	return &file_domain_order_order_grpc_proto_enumTypes[0]
+}
+
+func (x OrderEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderEventType.Descriptor instead.
+func (OrderEventType) EnumDescriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{0}
+}
+
 type ID struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
@@ -80,18 +139,22 @@ func (x *ID) GetSequence() int64 {
 
 // Ability to get all trade history views using the filter options
 type Filter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
-	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3,oneof" json:"From,omitempty"`
-	To            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3,oneof" json:"To,omitempty"`
-	Account       *string                `protobuf:"bytes,4,opt,name=Account,proto3,oneof" json:"Account,omitempty"`
-	Sequence      *int64                 `protobuf:"varint,5,opt,name=Sequence,proto3,oneof" json:"Sequence,omitempty"`
-	OrderID       *string                `protobuf:"bytes,6,opt,name=OrderID,proto3,oneof" json:"OrderID,omitempty"`
-	Denom1        *denom.Denom           `protobuf:"bytes,7,opt,name=Denom1,proto3,oneof" json:"Denom1,omitempty"`
-	Denom2        *denom.Denom           `protobuf:"bytes,8,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
-	Side          *order_properties.Side `protobuf:"varint,9,opt,name=Side,proto3,enum=orderproperties.Side,oneof" json:"Side,omitempty"`
-	Offset        *int64                 `protobuf:"varint,10,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
-	OrderStatus   *OrderStatus           `protobuf:"varint,11,opt,name=OrderStatus,proto3,enum=order.OrderStatus,oneof" json:"OrderStatus,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Network     metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	From        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3,oneof" json:"From,omitempty"`
+	To          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3,oneof" json:"To,omitempty"`
+	Account     *string                `protobuf:"bytes,4,opt,name=Account,proto3,oneof" json:"Account,omitempty"`
+	Sequence    *int64                 `protobuf:"varint,5,opt,name=Sequence,proto3,oneof" json:"Sequence,omitempty"`
+	OrderID     *string                `protobuf:"bytes,6,opt,name=OrderID,proto3,oneof" json:"OrderID,omitempty"`
+	Denom1      *denom.Denom           `protobuf:"bytes,7,opt,name=Denom1,proto3,oneof" json:"Denom1,omitempty"`
+	Denom2      *denom.Denom           `protobuf:"bytes,8,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
+	Side        *order_properties.Side `protobuf:"varint,9,opt,name=Side,proto3,enum=orderproperties.Side,oneof" json:"Side,omitempty"`
+	Offset      *int64                 `protobuf:"varint,10,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
+	OrderStatus *OrderStatus           `protobuf:"varint,11,opt,name=OrderStatus,proto3,enum=order.OrderStatus,oneof" json:"OrderStatus,omitempty"`
+	// Cursor as returned in Orders.NextCursor to continue paging from the previous result
+	Cursor *string `protobuf:"bytes,12,opt,name=Cursor,proto3,oneof" json:"Cursor,omitempty"`
+	// Maximum number of orders returned. If Limit and Cursor are both not set, all orders matching the filter are returned
+	Limit         *int32 `protobuf:"varint,13,opt,name=Limit,proto3,oneof" json:"Limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -203,82 +266,1239 @@ func (x *Filter) GetOrderStatus() OrderStatus {
 	return OrderStatus_ORDER_STATUS_UNSPECIFIED
 }
 
-var File_domain_order_order_grpc_proto protoreflect.FileDescriptor
+func (x *Filter) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
 
-var file_domain_order_order_grpc_proto_rawDesc = string([]byte{
-	0x0a, 0x1d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x2b,
-	0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x53,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc7, 0x04, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
-	0x33, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x46, 0x72, 0x6f,
-	0x6d, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x02,
-	0x54, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x48, 0x05, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x29, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x48, 0x06, 0x52,
-	0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x53, 0x69,
-	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x48,
-	0x07, 0x52, 0x04, 0x53, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x06, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x09, 0x52, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88,
-	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x54, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x53, 0x69, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x32, 0xbe, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0c, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x09, 0x2e, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x2e, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0d, 0x2e, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Filter) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type OrderBookID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	BaseDenom     string                 `protobuf:"bytes,2,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom    string                 `protobuf:"bytes,3,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookID) Reset() {
+	*x = OrderBookID{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookID) ProtoMessage() {}
+
+func (x *OrderBookID) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookID.ProtoReflect.Descriptor instead.
+func (*OrderBookID) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OrderBookID) GetNetwork() metadata.Network {
+	if x != nil {
+		return x.Network
+	}
+	return metadata.Network(0)
+}
+
+func (x *OrderBookID) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *OrderBookID) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+// OrderBook is the state of an on chain order book (BaseDenom/QuoteDenom) as maintained by the data-aggregator from the
+// DEX events. Orders are in the order of execution: Buys descending and sells ascending by price.
+type OrderBook struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BaseDenom   string                 `protobuf:"bytes,1,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom  string                 `protobuf:"bytes,2,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	Buy         []*OrderBookOrder      `protobuf:"bytes,3,rep,name=Buy,proto3" json:"Buy,omitempty"`
+	Sell        []*OrderBookOrder      `protobuf:"bytes,4,rep,name=Sell,proto3" json:"Sell,omitempty"`
+	BlockHeight int64                  `protobuf:"varint,5,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"` // Last block applied to the order book
+	BlockTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=BlockTime,proto3" json:"BlockTime,omitempty"`
+	// Last time the order book was reconciled with the chain. Readers should not use an order book which has not
+	// been reconciled recently (e.g. the data-aggregator is not running)
+	ReconciledAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ReconciledAt,proto3" json:"ReconciledAt,omitempty"`
+	MetaData      *metadata.MetaData     `protobuf:"bytes,20,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBook) Reset() {
+	*x = OrderBook{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBook) ProtoMessage() {}
+
+func (x *OrderBook) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBook.ProtoReflect.Descriptor instead.
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OrderBook) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *OrderBook) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *OrderBook) GetBuy() []*OrderBookOrder {
+	if x != nil {
+		return x.Buy
+	}
+	return nil
+}
+
+func (x *OrderBook) GetSell() []*OrderBookOrder {
+	if x != nil {
+		return x.Sell
+	}
+	return nil
+}
+
+func (x *OrderBook) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *OrderBook) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *OrderBook) GetReconciledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReconciledAt
+	}
+	return nil
+}
+
+func (x *OrderBook) GetMetaData() *metadata.MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+// OrderBookOrder is an open order in the order book. Amounts are in subunits, the price is the on chain price
+// (QuoteDenom subunits per BaseDenom subunit).
+type OrderBookOrder struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sequence          uint64                 `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Account           string                 `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	OrderID           string                 `protobuf:"bytes,3,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	Price             string                 `protobuf:"bytes,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	Quantity          string                 `protobuf:"bytes,5,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	RemainingQuantity string                 `protobuf:"bytes,6,opt,name=RemainingQuantity,proto3" json:"RemainingQuantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderBookOrder) Reset() {
+	*x = OrderBookOrder{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookOrder) ProtoMessage() {}
+
+func (x *OrderBookOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookOrder.ProtoReflect.Descriptor instead.
+func (*OrderBookOrder) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderBookOrder) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderBookOrder) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OrderBookOrder) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *OrderBookOrder) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *OrderBookOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *OrderBookOrder) GetRemainingQuantity() string {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return ""
+}
+
+// OrderBookSnapshot is the top of an order book at a block, with the metrics over the orders in the snapshot
+type OrderBookSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseDenom     string                 `protobuf:"bytes,1,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom    string                 `protobuf:"bytes,2,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	Buy           []*OrderBookOrder      `protobuf:"bytes,3,rep,name=Buy,proto3" json:"Buy,omitempty"`
+	Sell          []*OrderBookOrder      `protobuf:"bytes,4,rep,name=Sell,proto3" json:"Sell,omitempty"`
+	BlockHeight   int64                  `protobuf:"varint,5,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockTime     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=BlockTime,proto3" json:"BlockTime,omitempty"`
+	Metrics       *OrderBookMetrics      `protobuf:"bytes,7,opt,name=Metrics,proto3" json:"Metrics,omitempty"`
+	MetaData      *metadata.MetaData     `protobuf:"bytes,20,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookSnapshot) Reset() {
+	*x = OrderBookSnapshot{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookSnapshot) ProtoMessage() {}
+
+func (x *OrderBookSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookSnapshot.ProtoReflect.Descriptor instead.
+func (*OrderBookSnapshot) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrderBookSnapshot) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshot) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshot) GetBuy() []*OrderBookOrder {
+	if x != nil {
+		return x.Buy
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshot) GetSell() []*OrderBookOrder {
+	if x != nil {
+		return x.Sell
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshot) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *OrderBookSnapshot) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshot) GetMetrics() *OrderBookMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshot) GetMetaData() *metadata.MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+// OrderBookMetrics are calculated over the orders of a snapshot, in the units of the order book (subunits, on chain
+// prices).
+type OrderBookMetrics struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BestBuy        string                 `protobuf:"bytes,1,opt,name=BestBuy,proto3" json:"BestBuy,omitempty"`             // Empty if there are no buys
+	BestSell       string                 `protobuf:"bytes,2,opt,name=BestSell,proto3" json:"BestSell,omitempty"`           // Empty if there are no sells
+	BuyDepth       string                 `protobuf:"bytes,3,opt,name=BuyDepth,proto3" json:"BuyDepth,omitempty"`           // Remaining quantity (BaseDenom) of the buys
+	BuyDepthQuote  string                 `protobuf:"bytes,4,opt,name=BuyDepthQuote,proto3" json:"BuyDepthQuote,omitempty"` // Value of the buys in QuoteDenom
+	SellDepth      string                 `protobuf:"bytes,5,opt,name=SellDepth,proto3" json:"SellDepth,omitempty"`
+	SellDepthQuote string                 `protobuf:"bytes,6,opt,name=SellDepthQuote,proto3" json:"SellDepthQuote,omitempty"`
+	BuyOrders      int32                  `protobuf:"varint,7,opt,name=BuyOrders,proto3" json:"BuyOrders,omitempty"`
+	SellOrders     int32                  `protobuf:"varint,8,opt,name=SellOrders,proto3" json:"SellOrders,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderBookMetrics) Reset() {
+	*x = OrderBookMetrics{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookMetrics) ProtoMessage() {}
+
+func (x *OrderBookMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookMetrics.ProtoReflect.Descriptor instead.
+func (*OrderBookMetrics) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderBookMetrics) GetBestBuy() string {
+	if x != nil {
+		return x.BestBuy
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetBestSell() string {
+	if x != nil {
+		return x.BestSell
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetBuyDepth() string {
+	if x != nil {
+		return x.BuyDepth
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetBuyDepthQuote() string {
+	if x != nil {
+		return x.BuyDepthQuote
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetSellDepth() string {
+	if x != nil {
+		return x.SellDepth
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetSellDepthQuote() string {
+	if x != nil {
+		return x.SellDepthQuote
+	}
+	return ""
+}
+
+func (x *OrderBookMetrics) GetBuyOrders() int32 {
+	if x != nil {
+		return x.BuyOrders
+	}
+	return 0
+}
+
+func (x *OrderBookMetrics) GetSellOrders() int32 {
+	if x != nil {
+		return x.SellOrders
+	}
+	return 0
+}
+
+type OrderBookSnapshots struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshots     []*OrderBookSnapshot   `protobuf:"bytes,1,rep,name=Snapshots,proto3" json:"Snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookSnapshots) Reset() {
+	*x = OrderBookSnapshots{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookSnapshots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookSnapshots) ProtoMessage() {}
+
+func (x *OrderBookSnapshots) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookSnapshots.ProtoReflect.Descriptor instead.
+func (*OrderBookSnapshots) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OrderBookSnapshots) GetSnapshots() []*OrderBookSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type OrderBookSnapshotQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	BaseDenom     string                 `protobuf:"bytes,2,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom    string                 `protobuf:"bytes,3,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=At,proto3" json:"At,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookSnapshotQuery) Reset() {
+	*x = OrderBookSnapshotQuery{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookSnapshotQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookSnapshotQuery) ProtoMessage() {}
+
+func (x *OrderBookSnapshotQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookSnapshotQuery.ProtoReflect.Descriptor instead.
+func (*OrderBookSnapshotQuery) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrderBookSnapshotQuery) GetNetwork() metadata.Network {
+	if x != nil {
+		return x.Network
+	}
+	return metadata.Network(0)
+}
+
+func (x *OrderBookSnapshotQuery) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshotQuery) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshotQuery) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type OrderBookSnapshotSeriesFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	BaseDenom     string                 `protobuf:"bytes,2,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom    string                 `protobuf:"bytes,3,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=To,proto3" json:"To,omitempty"`
+	Interval      int64                  `protobuf:"varint,6,opt,name=Interval,proto3" json:"Interval,omitempty"` // Seconds, intervals are aligned to multiples of the interval since the epoch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderBookSnapshotSeriesFilter) Reset() {
+	*x = OrderBookSnapshotSeriesFilter{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderBookSnapshotSeriesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderBookSnapshotSeriesFilter) ProtoMessage() {}
+
+func (x *OrderBookSnapshotSeriesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderBookSnapshotSeriesFilter.ProtoReflect.Descriptor instead.
+func (*OrderBookSnapshotSeriesFilter) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetNetwork() metadata.Network {
+	if x != nil {
+		return x.Network
+	}
+	return metadata.Network(0)
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetQuoteDenom() string {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return ""
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *OrderBookSnapshotSeriesFilter) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// OrderEvent is a change in the lifecycle of an order, recorded when the order is upserted with a new status or a
+// lower remaining quantity. Unique by Network, Sequence, Type and RemainingQuantity.
+type OrderEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              OrderEventType         `protobuf:"varint,1,opt,name=Type,proto3,enum=order.OrderEventType" json:"Type,omitempty"`
+	Account           string                 `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	Sequence          int64                  `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	OrderID           string                 `protobuf:"bytes,4,opt,name=OrderID,proto3" json:"OrderID,omitempty"`
+	BaseDenom         *denom.Denom           `protobuf:"bytes,5,opt,name=BaseDenom,proto3" json:"BaseDenom,omitempty"`
+	QuoteDenom        *denom.Denom           `protobuf:"bytes,6,opt,name=QuoteDenom,proto3" json:"QuoteDenom,omitempty"`
+	Side              order_properties.Side  `protobuf:"varint,7,opt,name=Side,proto3,enum=orderproperties.Side" json:"Side,omitempty"`
+	Price             float64                `protobuf:"fixed64,8,opt,name=Price,proto3" json:"Price,omitempty"`
+	Quantity          *decimal.Decimal       `protobuf:"bytes,9,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	RemainingQuantity *decimal.Decimal       `protobuf:"bytes,10,opt,name=RemainingQuantity,proto3" json:"RemainingQuantity,omitempty"` // After the event
+	TXID              string                 `protobuf:"bytes,11,opt,name=TXID,proto3" json:"TXID,omitempty"`                           // Transaction which triggered the event, empty for end block events (expiry)
+	BlockHeight       int64                  `protobuf:"varint,12,opt,name=BlockHeight,proto3" json:"BlockHeight,omitempty"`
+	BlockTime         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=BlockTime,proto3" json:"BlockTime,omitempty"`
+	MetaData          *metadata.MetaData     `protobuf:"bytes,20,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OrderEvent) GetType() OrderEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *OrderEvent) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetOrderID() string {
+	if x != nil {
+		return x.OrderID
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetBaseDenom() *denom.Denom {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetQuoteDenom() *denom.Denom {
+	if x != nil {
+		return x.QuoteDenom
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetSide() order_properties.Side {
+	if x != nil {
+		return x.Side
+	}
+	return order_properties.Side(0)
+}
+
+func (x *OrderEvent) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetQuantity() *decimal.Decimal {
+	if x != nil {
+		return x.Quantity
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetRemainingQuantity() *decimal.Decimal {
+	if x != nil {
+		return x.RemainingQuantity
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetTXID() string {
+	if x != nil {
+		return x.TXID
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetBlockTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTime
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetMetaData() *metadata.MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+type OrderEvents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*OrderEvent          `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvents) Reset() {
+	*x = OrderEvents{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvents) ProtoMessage() {}
+
+func (x *OrderEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvents.ProtoReflect.Descriptor instead.
+func (*OrderEvents) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OrderEvents) GetEvents() []*OrderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type OrderEventFilter struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	Account string                 `protobuf:"bytes,2,opt,name=Account,proto3" json:"Account,omitempty"`
+	// The events of a market, in either direction (optional)
+	Denom1        *string                `protobuf:"bytes,3,opt,name=Denom1,proto3,oneof" json:"Denom1,omitempty"`
+	Denom2        *string                `protobuf:"bytes,4,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=From,proto3" json:"From,omitempty"` // Block time, inclusive
+	To            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=To,proto3" json:"To,omitempty"`     // Block time, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEventFilter) Reset() {
+	*x = OrderEventFilter{}
+	mi := &file_domain_order_order_grpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEventFilter) ProtoMessage() {}
+
+func (x *OrderEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_domain_order_order_grpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEventFilter.ProtoReflect.Descriptor instead.
+func (*OrderEventFilter) Descriptor() ([]byte, []int) {
+	return file_domain_order_order_grpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OrderEventFilter) GetNetwork() metadata.Network {
+	if x != nil {
+		return x.Network
+	}
+	return metadata.Network(0)
+}
+
+func (x *OrderEventFilter) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *OrderEventFilter) GetDenom1() string {
+	if x != nil && x.Denom1 != nil {
+		return *x.Denom1
+	}
+	return ""
+}
+
+func (x *OrderEventFilter) GetDenom2() string {
+	if x != nil && x.Denom2 != nil {
+		return *x.Denom2
+	}
+	return ""
+}
+
+func (x *OrderEventFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *OrderEventFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+var File_domain_order_order_grpc_proto protoreflect.FileDescriptor
+
+var file_domain_order_order_grpc_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2f, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x94, 0x05, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x02, 0x54, 0x6f,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x48, 0x05, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x48, 0x06, 0x52, 0x06, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x48, 0x07, 0x52,
+	0x04, 0x53, 0x69, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x09,
+	0x52, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0a, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x0b, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x46, 0x72, 0x6f,
+	0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x54, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x32, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x53, 0x69, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x73,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xe9, 0x02, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x03, 0x42, 0x75, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x03, 0x42, 0x75, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x53,
+	0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x04, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xe4, 0x02, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x42,
+	0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x27, 0x0a, 0x03, 0x42, 0x75, 0x79,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x03, 0x42,
+	0x75, 0x79, 0x12, 0x29, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x20, 0x0a,
+	0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2e, 0x0a, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8e, 0x02, 0x0a,
+	0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x65, 0x73, 0x74, 0x42, 0x75, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x42, 0x65, 0x73, 0x74, 0x42, 0x75, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x42,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x75, 0x79, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x75, 0x79, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d, 0x42, 0x75, 0x79, 0x44, 0x65, 0x70, 0x74, 0x68, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x42, 0x75, 0x79, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6c,
+	0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65,
+	0x6c, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x6c, 0x6c, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x53, 0x65, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x74, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a,
+	0x12, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x09, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x16,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x41, 0x74, 0x22, 0x82, 0x02,
+	0x0a, 0x1d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x22, 0xb0, 0x04, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x09,
+	0x42, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x09, 0x42,
+	0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2c, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0a, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x53, 0x69, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x08, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x52, 0x11, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x58, 0x49, 0x44, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x58, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x85, 0x02, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54,
+	0x6f, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x2a, 0xb7, 0x01, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c, 0x4c, 0x59, 0x5f, 0x46, 0x49,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18,
+	0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x05, 0x32, 0xed, 0x04, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0c, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x09, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0d, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12,
+	0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f,
+	0x6b, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x4b, 0x0a, 0x17, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f,
+	0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x24, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x12, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -293,41 +1513,97 @@ func file_domain_order_order_grpc_proto_rawDescGZIP() []byte {
 	return file_domain_order_order_grpc_proto_rawDescData
 }
 
-var file_domain_order_order_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_domain_order_order_grpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_domain_order_order_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_domain_order_order_grpc_proto_goTypes = []any{
-	(*ID)(nil),                    // 0: order.ID
-	(*Filter)(nil),                // 1: order.Filter
-	(metadata.Network)(0),         // 2: metadata.Network
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*denom.Denom)(nil),           // 4: denom.Denom
-	(order_properties.Side)(0),    // 5: orderproperties.Side
-	(OrderStatus)(0),              // 6: order.OrderStatus
-	(*Order)(nil),                 // 7: order.Order
-	(*Orders)(nil),                // 8: order.Orders
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(OrderEventType)(0),                   // 0: order.OrderEventType
+	(*ID)(nil),                            // 1: order.ID
+	(*Filter)(nil),                        // 2: order.Filter
+	(*OrderBookID)(nil),                   // 3: order.OrderBookID
+	(*OrderBook)(nil),                     // 4: order.OrderBook
+	(*OrderBookOrder)(nil),                // 5: order.OrderBookOrder
+	(*OrderBookSnapshot)(nil),             // 6: order.OrderBookSnapshot
+	(*OrderBookMetrics)(nil),              // 7: order.OrderBookMetrics
+	(*OrderBookSnapshots)(nil),            // 8: order.OrderBookSnapshots
+	(*OrderBookSnapshotQuery)(nil),        // 9: order.OrderBookSnapshotQuery
+	(*OrderBookSnapshotSeriesFilter)(nil), // 10: order.OrderBookSnapshotSeriesFilter
+	(*OrderEvent)(nil),                    // 11: order.OrderEvent
+	(*OrderEvents)(nil),                   // 12: order.OrderEvents
+	(*OrderEventFilter)(nil),              // 13: order.OrderEventFilter
+	(metadata.Network)(0),                 // 14: metadata.Network
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+	(*denom.Denom)(nil),                   // 16: denom.Denom
+	(order_properties.Side)(0),            // 17: orderproperties.Side
+	(OrderStatus)(0),                      // 18: order.OrderStatus
+	(*metadata.MetaData)(nil),             // 19: metadata.MetaData
+	(*decimal.Decimal)(nil),               // 20: decimal.Decimal
+	(*Order)(nil),                         // 21: order.Order
+	(*Orders)(nil),                        // 22: order.Orders
+	(*emptypb.Empty)(nil),                 // 23: google.protobuf.Empty
 }
 var file_domain_order_order_grpc_proto_depIdxs = []int32{
-	2,  // 0: order.ID.Network:type_name -> metadata.Network
-	2,  // 1: order.Filter.Network:type_name -> metadata.Network
-	3,  // 2: order.Filter.From:type_name -> google.protobuf.Timestamp
-	3,  // 3: order.Filter.To:type_name -> google.protobuf.Timestamp
-	4,  // 4: order.Filter.Denom1:type_name -> denom.Denom
-	4,  // 5: order.Filter.Denom2:type_name -> denom.Denom
-	5,  // 6: order.Filter.Side:type_name -> orderproperties.Side
-	6,  // 7: order.Filter.OrderStatus:type_name -> order.OrderStatus
-	7,  // 8: order.OrderService.Upsert:input_type -> order.Order
-	0,  // 9: order.OrderService.Get:input_type -> order.ID
-	1,  // 10: order.OrderService.GetAll:input_type -> order.Filter
-	8,  // 11: order.OrderService.BatchUpsert:input_type -> order.Orders
-	9,  // 12: order.OrderService.Upsert:output_type -> google.protobuf.Empty
-	7,  // 13: order.OrderService.Get:output_type -> order.Order
-	8,  // 14: order.OrderService.GetAll:output_type -> order.Orders
-	9,  // 15: order.OrderService.BatchUpsert:output_type -> google.protobuf.Empty
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 0: order.ID.Network:type_name -> metadata.Network
+	14, // 1: order.Filter.Network:type_name -> metadata.Network
+	15, // 2: order.Filter.From:type_name -> google.protobuf.Timestamp
+	15, // 3: order.Filter.To:type_name -> google.protobuf.Timestamp
+	16, // 4: order.Filter.Denom1:type_name -> denom.Denom
+	16, // 5: order.Filter.Denom2:type_name -> denom.Denom
+	17, // 6: order.Filter.Side:type_name -> orderproperties.Side
+	18, // 7: order.Filter.OrderStatus:type_name -> order.OrderStatus
+	14, // 8: order.OrderBookID.Network:type_name -> metadata.Network
+	5,  // 9: order.OrderBook.Buy:type_name -> order.OrderBookOrder
+	5,  // 10: order.OrderBook.Sell:type_name -> order.OrderBookOrder
+	15, // 11: order.OrderBook.BlockTime:type_name -> google.protobuf.Timestamp
+	15, // 12: order.OrderBook.ReconciledAt:type_name -> google.protobuf.Timestamp
+	19, // 13: order.OrderBook.MetaData:type_name -> metadata.MetaData
+	5,  // 14: order.OrderBookSnapshot.Buy:type_name -> order.OrderBookOrder
+	5,  // 15: order.OrderBookSnapshot.Sell:type_name -> order.OrderBookOrder
+	15, // 16: order.OrderBookSnapshot.BlockTime:type_name -> google.protobuf.Timestamp
+	7,  // 17: order.OrderBookSnapshot.Metrics:type_name -> order.OrderBookMetrics
+	19, // 18: order.OrderBookSnapshot.MetaData:type_name -> metadata.MetaData
+	6,  // 19: order.OrderBookSnapshots.Snapshots:type_name -> order.OrderBookSnapshot
+	14, // 20: order.OrderBookSnapshotQuery.Network:type_name -> metadata.Network
+	15, // 21: order.OrderBookSnapshotQuery.At:type_name -> google.protobuf.Timestamp
+	14, // 22: order.OrderBookSnapshotSeriesFilter.Network:type_name -> metadata.Network
+	15, // 23: order.OrderBookSnapshotSeriesFilter.From:type_name -> google.protobuf.Timestamp
+	15, // 24: order.OrderBookSnapshotSeriesFilter.To:type_name -> google.protobuf.Timestamp
+	0,  // 25: order.OrderEvent.Type:type_name -> order.OrderEventType
+	16, // 26: order.OrderEvent.BaseDenom:type_name -> denom.Denom
+	16, // 27: order.OrderEvent.QuoteDenom:type_name -> denom.Denom
+	17, // 28: order.OrderEvent.Side:type_name -> orderproperties.Side
+	20, // 29: order.OrderEvent.Quantity:type_name -> decimal.Decimal
+	20, // 30: order.OrderEvent.RemainingQuantity:type_name -> decimal.Decimal
+	15, // 31: order.OrderEvent.BlockTime:type_name -> google.protobuf.Timestamp
+	19, // 32: order.OrderEvent.MetaData:type_name -> metadata.MetaData
+	11, // 33: order.OrderEvents.Events:type_name -> order.OrderEvent
+	14, // 34: order.OrderEventFilter.Network:type_name -> metadata.Network
+	15, // 35: order.OrderEventFilter.From:type_name -> google.protobuf.Timestamp
+	15, // 36: order.OrderEventFilter.To:type_name -> google.protobuf.Timestamp
+	21, // 37: order.OrderService.Upsert:input_type -> order.Order
+	1,  // 38: order.OrderService.Get:input_type -> order.ID
+	2,  // 39: order.OrderService.GetAll:input_type -> order.Filter
+	22, // 40: order.OrderService.BatchUpsert:input_type -> order.Orders
+	4,  // 41: order.OrderService.UpsertOrderBook:input_type -> order.OrderBook
+	3,  // 42: order.OrderService.GetOrderBook:input_type -> order.OrderBookID
+	6,  // 43: order.OrderService.UpsertOrderBookSnapshot:input_type -> order.OrderBookSnapshot
+	9,  // 44: order.OrderService.GetOrderBookSnapshot:input_type -> order.OrderBookSnapshotQuery
+	10, // 45: order.OrderService.GetOrderBookSnapshotSeries:input_type -> order.OrderBookSnapshotSeriesFilter
+	13, // 46: order.OrderService.GetOrderEvents:input_type -> order.OrderEventFilter
+	23, // 47: order.OrderService.Upsert:output_type -> google.protobuf.Empty
+	21, // 48: order.OrderService.Get:output_type -> order.Order
+	22, // 49: order.OrderService.GetAll:output_type -> order.Orders
+	23, // 50: order.OrderService.BatchUpsert:output_type -> google.protobuf.Empty
+	23, // 51: order.OrderService.UpsertOrderBook:output_type -> google.protobuf.Empty
+	4,  // 52: order.OrderService.GetOrderBook:output_type -> order.OrderBook
+	23, // 53: order.OrderService.UpsertOrderBookSnapshot:output_type -> google.protobuf.Empty
+	6,  // 54: order.OrderService.GetOrderBookSnapshot:output_type -> order.OrderBookSnapshot
+	8,  // 55: order.OrderService.GetOrderBookSnapshotSeries:output_type -> order.OrderBookSnapshots
+	12, // 56: order.OrderService.GetOrderEvents:output_type -> order.OrderEvents
+	47, // [47:57] is the sub-list for method output_type
+	37, // [37:47] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_domain_order_order_grpc_proto_init() }
@@ -337,18 +1613,20 @@ func file_domain_order_order_grpc_proto_init() {
 	}
 	file_domain_order_order_proto_init()
 	file_domain_order_order_grpc_proto_msgTypes[1].OneofWrappers = []any{}
+	file_domain_order_order_grpc_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_order_order_grpc_proto_rawDesc), len(file_domain_order_order_grpc_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_domain_order_order_grpc_proto_goTypes,
 		DependencyIndexes: file_domain_order_order_grpc_proto_depIdxs,
+		EnumInfos:         file_domain_order_order_grpc_proto_enumTypes,
 		MessageInfos:      file_domain_order_order_grpc_proto_msgTypes,
 	}.Build()
 	File_domain_order_order_grpc_proto = out.File
@@ -20,10 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_Upsert_FullMethodName      = "/order.OrderService/Upsert"
-	OrderService_Get_FullMethodName         = "/order.OrderService/Get"
-	OrderService_GetAll_FullMethodName      = "/order.OrderService/GetAll"
-	OrderService_BatchUpsert_FullMethodName = "/order.OrderService/BatchUpsert"
+	OrderService_Upsert_FullMethodName                     = "/order.OrderService/Upsert"
+	OrderService_Get_FullMethodName                        = "/order.OrderService/Get"
+	OrderService_GetAll_FullMethodName                     = "/order.OrderService/GetAll"
+	OrderService_BatchUpsert_FullMethodName                = "/order.OrderService/BatchUpsert"
+	OrderService_UpsertOrderBook_FullMethodName            = "/order.OrderService/UpsertOrderBook"
+	OrderService_GetOrderBook_FullMethodName               = "/order.OrderService/GetOrderBook"
+	OrderService_UpsertOrderBookSnapshot_FullMethodName    = "/order.OrderService/UpsertOrderBookSnapshot"
+	OrderService_GetOrderBookSnapshot_FullMethodName       = "/order.OrderService/GetOrderBookSnapshot"
+	OrderService_GetOrderBookSnapshotSeries_FullMethodName = "/order.OrderService/GetOrderBookSnapshotSeries"
+	OrderService_GetOrderEvents_FullMethodName             = "/order.OrderService/GetOrderEvents"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -35,6 +41,17 @@ type OrderServiceClient interface {
 	Get(ctx context.Context, in *ID, opts ...grpc.CallOption) (*Order, error)
 	GetAll(ctx context.Context, in *Filter, opts ...grpc.CallOption) (*Orders, error)
 	BatchUpsert(ctx context.Context, in *Orders, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Replaces the order book maintained by the data-aggregator
+	UpsertOrderBook(ctx context.Context, in *OrderBook, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetOrderBook(ctx context.Context, in *OrderBookID, opts ...grpc.CallOption) (*OrderBook, error)
+	// Stores a snapshot of the top of an order book (replaces a snapshot of the same block)
+	UpsertOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshot, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the latest snapshot at or before the requested time
+	GetOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshotQuery, opts ...grpc.CallOption) (*OrderBookSnapshot, error)
+	// Returns the last snapshot of each interval, without the orders
+	GetOrderBookSnapshotSeries(ctx context.Context, in *OrderBookSnapshotSeriesFilter, opts ...grpc.CallOption) (*OrderBookSnapshots, error)
+	// Returns the lifecycle events of the orders as recorded by Upsert, newest first
+	GetOrderEvents(ctx context.Context, in *OrderEventFilter, opts ...grpc.CallOption) (*OrderEvents, error)
 }
 
 type orderServiceClient struct {
@@ -85,6 +102,66 @@ func (c *orderServiceClient) BatchUpsert(ctx context.Context, in *Orders, opts .
 	return out, nil
 }
 
+func (c *orderServiceClient) UpsertOrderBook(ctx context.Context, in *OrderBook, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_UpsertOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderBook(ctx context.Context, in *OrderBookID, opts ...grpc.CallOption) (*OrderBook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBook)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderBook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpsertOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshot, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_UpsertOrderBookSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderBookSnapshot(ctx context.Context, in *OrderBookSnapshotQuery, opts ...grpc.CallOption) (*OrderBookSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBookSnapshot)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderBookSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderBookSnapshotSeries(ctx context.Context, in *OrderBookSnapshotSeriesFilter, opts ...grpc.CallOption) (*OrderBookSnapshots, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderBookSnapshots)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderBookSnapshotSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderEvents(ctx context.Context, in *OrderEventFilter, opts ...grpc.CallOption) (*OrderEvents, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderEvents)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations should embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -94,6 +171,17 @@ type OrderServiceServer interface {
 	Get(context.Context, *ID) (*Order, error)
 	GetAll(context.Context, *Filter) (*Orders, error)
 	BatchUpsert(context.Context, *Orders) (*emptypb.Empty, error)
+	// Replaces the order book maintained by the data-aggregator
+	UpsertOrderBook(context.Context, *OrderBook) (*emptypb.Empty, error)
+	GetOrderBook(context.Context, *OrderBookID) (*OrderBook, error)
+	// Stores a snapshot of the top of an order book (replaces a snapshot of the same block)
+	UpsertOrderBookSnapshot(context.Context, *OrderBookSnapshot) (*emptypb.Empty, error)
+	// Returns the latest snapshot at or before the requested time
+	GetOrderBookSnapshot(context.Context, *OrderBookSnapshotQuery) (*OrderBookSnapshot, error)
+	// Returns the last snapshot of each interval, without the orders
+	GetOrderBookSnapshotSeries(context.Context, *OrderBookSnapshotSeriesFilter) (*OrderBookSnapshots, error)
+	// Returns the lifecycle events of the orders as recorded by Upsert, newest first
+	GetOrderEvents(context.Context, *OrderEventFilter) (*OrderEvents, error)
 }
 
 // UnimplementedOrderServiceServer should be embedded to have
@@ -115,6 +203,24 @@ func (UnimplementedOrderServiceServer) GetAll(context.Context, *Filter) (*Orders
 func (UnimplementedOrderServiceServer) BatchUpsert(context.Context, *Orders) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsert not implemented")
 }
+func (UnimplementedOrderServiceServer) UpsertOrderBook(context.Context, *OrderBook) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertOrderBook not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderBook(context.Context, *OrderBookID) (*OrderBook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
+}
+func (UnimplementedOrderServiceServer) UpsertOrderBookSnapshot(context.Context, *OrderBookSnapshot) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertOrderBookSnapshot not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderBookSnapshot(context.Context, *OrderBookSnapshotQuery) (*OrderBookSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBookSnapshot not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderBookSnapshotSeries(context.Context, *OrderBookSnapshotSeriesFilter) (*OrderBookSnapshots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBookSnapshotSeries not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderEvents(context.Context, *OrderEventFilter) (*OrderEvents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderEvents not implemented")
+}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -207,6 +313,114 @@ func _OrderService_BatchUpsert_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_UpsertOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpsertOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpsertOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpsertOrderBook(ctx, req.(*OrderBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderBook(ctx, req.(*OrderBookID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpsertOrderBookSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookSnapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpsertOrderBookSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpsertOrderBookSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpsertOrderBookSnapshot(ctx, req.(*OrderBookSnapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderBookSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderBookSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderBookSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderBookSnapshot(ctx, req.(*OrderBookSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderBookSnapshotSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderBookSnapshotSeriesFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderBookSnapshotSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderBookSnapshotSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderBookSnapshotSeries(ctx, req.(*OrderBookSnapshotSeriesFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderEventFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderEvents(ctx, req.(*OrderEventFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +444,30 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpsert",
 			Handler:    _OrderService_BatchUpsert_Handler,
 		},
+		{
+			MethodName: "UpsertOrderBook",
+			Handler:    _OrderService_UpsertOrderBook_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _OrderService_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "UpsertOrderBookSnapshot",
+			Handler:    _OrderService_UpsertOrderBookSnapshot_Handler,
+		},
+		{
+			MethodName: "GetOrderBookSnapshot",
+			Handler:    _OrderService_GetOrderBookSnapshot_Handler,
+		},
+		{
+			MethodName: "GetOrderBookSnapshotSeries",
+			Handler:    _OrderService_GetOrderBookSnapshotSeries_Handler,
+		},
+		{
+			MethodName: "GetOrderEvents",
+			Handler:    _OrderService_GetOrderEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "domain/order/order-grpc.proto",
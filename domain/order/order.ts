@@ -202,6 +202,14 @@ export interface Order {
   BlockHeight: number;
   /** If the order has been enriched with precision data */
   Enriched: boolean;
+  /**
+   * The transaction and block which triggered this update of the order (placement, match, cancellation or expiry).
+   * Not stored with the order: The store records them with the lifecycle event of the update (OrderEvent).
+   * The TXID, BlockHeight and BlockTime of the order are used when not set.
+   */
+  EventTXID: string;
+  EventBlockHeight: number;
+  EventBlockTime: Date | undefined;
 }
 
 /** GoodTil is a good til order settings. */
@@ -215,6 +223,8 @@ export interface GoodTil {
 export interface Orders {
   Orders: Order[];
   Offset?: number | undefined;
+  /** Opaque cursor to retrieve the next page (not set if there are no more results) */
+  NextCursor?: string | undefined;
 }
 
 function createBaseOrder(): Order {
@@ -238,6 +248,9 @@ function createBaseOrder(): Order {
     TXID: undefined,
     BlockHeight: 0,
     Enriched: false,
+    EventTXID: "",
+    EventBlockHeight: 0,
+    EventBlockTime: undefined,
   };
 }
 
@@ -300,6 +313,15 @@ export const Order = {
     if (message.Enriched !== false) {
       writer.uint32(184).bool(message.Enriched);
     }
+    if (message.EventTXID !== "") {
+      writer.uint32(194).string(message.EventTXID);
+    }
+    if (message.EventBlockHeight !== 0) {
+      writer.uint32(200).int64(message.EventBlockHeight);
+    }
+    if (message.EventBlockTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.EventBlockTime), writer.uint32(210).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -443,6 +465,27 @@ export const Order = {
 
           message.Enriched = reader.bool();
           continue;
+        case 24:
+          if (tag !== 194) {
+            break;
+          }
+
+          message.EventTXID = reader.string();
+          continue;
+        case 25:
+          if (tag !== 200) {
+            break;
+          }
+
+          message.EventBlockHeight = longToNumber(reader.int64() as Long);
+          continue;
+        case 26:
+          if (tag !== 210) {
+            break;
+          }
+
+          message.EventBlockTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -473,6 +516,9 @@ export const Order = {
       TXID: isSet(object.TXID) ? globalThis.String(object.TXID) : undefined,
       BlockHeight: isSet(object.BlockHeight) ? globalThis.Number(object.BlockHeight) : 0,
       Enriched: isSet(object.Enriched) ? globalThis.Boolean(object.Enriched) : false,
+      EventTXID: isSet(object.EventTXID) ? globalThis.String(object.EventTXID) : "",
+      EventBlockHeight: isSet(object.EventBlockHeight) ? globalThis.Number(object.EventBlockHeight) : 0,
+      EventBlockTime: isSet(object.EventBlockTime) ? fromJsonTimestamp(object.EventBlockTime) : undefined,
     };
   },
 
@@ -535,6 +581,15 @@ export const Order = {
     if (message.Enriched !== false) {
       obj.Enriched = message.Enriched;
     }
+    if (message.EventTXID !== "") {
+      obj.EventTXID = message.EventTXID;
+    }
+    if (message.EventBlockHeight !== 0) {
+      obj.EventBlockHeight = Math.round(message.EventBlockHeight);
+    }
+    if (message.EventBlockTime !== undefined) {
+      obj.EventBlockTime = message.EventBlockTime.toISOString();
+    }
     return obj;
   },
 
@@ -574,6 +629,9 @@ export const Order = {
     message.TXID = object.TXID ?? undefined;
     message.BlockHeight = object.BlockHeight ?? 0;
     message.Enriched = object.Enriched ?? false;
+    message.EventTXID = object.EventTXID ?? "";
+    message.EventBlockHeight = object.EventBlockHeight ?? 0;
+    message.EventBlockTime = object.EventBlockTime ?? undefined;
     return message;
   },
 };
@@ -653,7 +711,7 @@ export const GoodTil = {
 };
 
 function createBaseOrders(): Orders {
-  return { Orders: [], Offset: undefined };
+  return { Orders: [], Offset: undefined, NextCursor: undefined };
 }
 
 export const Orders = {
@@ -664,6 +722,9 @@ export const Orders = {
     if (message.Offset !== undefined) {
       writer.uint32(16).int32(message.Offset);
     }
+    if (message.NextCursor !== undefined) {
+      writer.uint32(26).string(message.NextCursor);
+    }
     return writer;
   },
 
@@ -688,6 +749,13 @@ export const Orders = {
 
           message.Offset = reader.int32();
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.NextCursor = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -701,6 +769,7 @@ export const Orders = {
     return {
       Orders: globalThis.Array.isArray(object?.Orders) ? object.Orders.map((e: any) => Order.fromJSON(e)) : [],
       Offset: isSet(object.Offset) ? globalThis.Number(object.Offset) : undefined,
+      NextCursor: isSet(object.NextCursor) ? globalThis.String(object.NextCursor) : undefined,
     };
   },
 
@@ -712,6 +781,9 @@ export const Orders = {
     if (message.Offset !== undefined) {
       obj.Offset = Math.round(message.Offset);
     }
+    if (message.NextCursor !== undefined) {
+      obj.NextCursor = message.NextCursor;
+    }
     return obj;
   },
 
@@ -722,6 +794,7 @@ export const Orders = {
     const message = createBaseOrders();
     message.Orders = object.Orders?.map((e) => Order.fromPartial(e)) || [];
     message.Offset = object.Offset ?? undefined;
+    message.NextCursor = object.NextCursor ?? undefined;
     return message;
   },
 };
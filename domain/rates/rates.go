@@ -194,6 +194,15 @@ func (f *Fetcher) ParseTradeExchangeRate(ctx context.Context, denom1 *denom.Deno
 	return fRes, nil
 }
 
+// USDC returns the denom the exchange rates resolve to, nil if there is no USDC configured for the network.
+// Rates are expressed in subunits of this denom per subunit of the converted denom.
+func (f *Fetcher) USDC() *denom.Denom {
+	if f.usdc == "" {
+		return nil
+	}
+	return &denom.Denom{Currency: f.usdc, Issuer: f.usdcIssuer}
+}
+
 func Key(base, target string) string {
 	return base + "-" + target
 }
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.0
-// 	protoc        v3.20.0
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
 // source: domain/state/state.proto
 
 package state
@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -26,6 +27,8 @@ type StateType int32
 const (
 	StateType_NOT_USED     StateType = 0
 	StateType_BLOCK_HEIGHT StateType = 1
+	// Height up to which the order books in the store are maintained by the data-aggregator, stored for every block
+	StateType_ORDERBOOK_HEIGHT StateType = 2
 )
 
 // Enum value maps for StateType.
@@ -33,10 +36,12 @@ var (
 	StateType_name = map[int32]string{
 		0: "NOT_USED",
 		1: "BLOCK_HEIGHT",
+		2: "ORDERBOOK_HEIGHT",
 	}
 	StateType_value = map[string]int32{
-		"NOT_USED":     0,
-		"BLOCK_HEIGHT": 1,
+		"NOT_USED":         0,
+		"BLOCK_HEIGHT":     1,
+		"ORDERBOOK_HEIGHT": 2,
 	}
 )
 
@@ -68,22 +73,19 @@ func (StateType) EnumDescriptor() ([]byte, []int) {
 }
 
 type State struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StateType     StateType              `protobuf:"varint,1,opt,name=StateType,proto3,enum=state.StateType" json:"StateType,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+	MetaData      *metadata.MetaData     `protobuf:"bytes,3,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	StateType StateType          `protobuf:"varint,1,opt,name=StateType,proto3,enum=state.StateType" json:"StateType,omitempty"`
-	Content   string             `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
-	MetaData  *metadata.MetaData `protobuf:"bytes,3,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *State) Reset() {
 	*x = State{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_state_state_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_state_state_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *State) String() string {
@@ -94,7 +96,7 @@ func (*State) ProtoMessage() {}
 
 func (x *State) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_state_state_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -132,7 +134,7 @@ func (x *State) GetMetaData() *metadata.MetaData {
 
 var File_domain_state_state_proto protoreflect.FileDescriptor
 
-var file_domain_state_state_proto_rawDesc = []byte{
+var file_domain_state_state_proto_rawDesc = string([]byte{
 	0x0a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x73,
 	0x74, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x74, 0x61, 0x74,
 	0x65, 0x1a, 0x1e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
@@ -145,31 +147,32 @@ var file_domain_state_state_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
 	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x2b, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x2a, 0x41, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x54, 0x79,
 	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x10, 0x00,
 	0x12, 0x10, 0x0a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54,
-	0x10, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6e, 0x6f, 0x72, 0x62, 0x65, 0x72, 0x74, 0x76, 0x61, 0x6e, 0x6e, 0x6f, 0x62, 0x65, 0x6c,
-	0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x2d, 0x64, 0x65, 0x78, 0x2d, 0x61, 0x70,
-	0x69, 0x2d, 0x76, 0x32, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x3b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x02, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d,
+	0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x3b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
 
 var (
 	file_domain_state_state_proto_rawDescOnce sync.Once
-	file_domain_state_state_proto_rawDescData = file_domain_state_state_proto_rawDesc
+	file_domain_state_state_proto_rawDescData []byte
 )
 
 func file_domain_state_state_proto_rawDescGZIP() []byte {
 	file_domain_state_state_proto_rawDescOnce.Do(func() {
-		file_domain_state_state_proto_rawDescData = protoimpl.X.CompressGZIP(file_domain_state_state_proto_rawDescData)
+		file_domain_state_state_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_domain_state_state_proto_rawDesc), len(file_domain_state_state_proto_rawDesc)))
 	})
 	return file_domain_state_state_proto_rawDescData
 }
 
 var file_domain_state_state_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_domain_state_state_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_domain_state_state_proto_goTypes = []interface{}{
+var file_domain_state_state_proto_goTypes = []any{
 	(StateType)(0),            // 0: state.StateType
 	(*State)(nil),             // 1: state.State
 	(*metadata.MetaData)(nil), // 2: metadata.MetaData
@@ -189,25 +192,11 @@ func file_domain_state_state_proto_init() {
 	if File_domain_state_state_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_domain_state_state_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_domain_state_state_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_state_state_proto_rawDesc), len(file_domain_state_state_proto_rawDesc)),
 			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
@@ -219,7 +208,6 @@ func file_domain_state_state_proto_init() {
 		MessageInfos:      file_domain_state_state_proto_msgTypes,
 	}.Build()
 	File_domain_state_state_proto = out.File
-	file_domain_state_state_proto_rawDesc = nil
 	file_domain_state_state_proto_goTypes = nil
 	file_domain_state_state_proto_depIdxs = nil
 }
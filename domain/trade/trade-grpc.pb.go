@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.0
-// 	protoc        v3.20.0
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
 // source: domain/trade/trade-grpc.proto
 
 package trade
@@ -16,6 +16,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -26,22 +27,19 @@ const (
 )
 
 type ID struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	TXID          string                 `protobuf:"bytes,2,opt,name=TXID,proto3" json:"TXID,omitempty"`
+	Sequence      int64                  `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Network  metadata.Network `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
-	TXID     string           `protobuf:"bytes,2,opt,name=TXID,proto3" json:"TXID,omitempty"`
-	Sequence int64            `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ID) Reset() {
 	*x = ID{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_grpc_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_grpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ID) String() string {
@@ -52,7 +50,7 @@ func (*ID) ProtoMessage() {}
 
 func (x *ID) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_grpc_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -90,10 +88,7 @@ func (x *ID) GetSequence() int64 {
 
 // Ability to get all trade history views using the filter options
 type Filter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
+	state    protoimpl.MessageState `protogen:"open.v1"`
 	Network  metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
 	From     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3,oneof" json:"From,omitempty"`
 	To       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3,oneof" json:"To,omitempty"`
@@ -105,15 +100,19 @@ type Filter struct {
 	Denom2   *denom.Denom           `protobuf:"bytes,9,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
 	Offset   *int64                 `protobuf:"varint,10,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
 	Side     *order_properties.Side `protobuf:"varint,11,opt,name=Side,proto3,enum=orderproperties.Side,oneof" json:"Side,omitempty"`
+	// Cursor as returned in Trades.NextCursor to continue paging from the previous result
+	Cursor *string `protobuf:"bytes,12,opt,name=Cursor,proto3,oneof" json:"Cursor,omitempty"`
+	// Maximum number of trades returned (defaults to 50 when no From is provided)
+	Limit         *int32 `protobuf:"varint,13,opt,name=Limit,proto3,oneof" json:"Limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Filter) Reset() {
 	*x = Filter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_grpc_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_grpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Filter) String() string {
@@ -124,7 +123,7 @@ func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_grpc_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -216,24 +215,35 @@ func (x *Filter) GetSide() order_properties.Side {
 	return order_properties.Side(0)
 }
 
+func (x *Filter) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *Filter) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
 type TradePairFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       metadata.Network       `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	Denom1        *denom.Denom           `protobuf:"bytes,2,opt,name=Denom1,proto3,oneof" json:"Denom1,omitempty"`
+	Denom2        *denom.Denom           `protobuf:"bytes,3,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
+	Offset        *int32                 `protobuf:"varint,4,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Network metadata.Network `protobuf:"varint,1,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
-	Denom1  *denom.Denom     `protobuf:"bytes,2,opt,name=Denom1,proto3,oneof" json:"Denom1,omitempty"`
-	Denom2  *denom.Denom     `protobuf:"bytes,3,opt,name=Denom2,proto3,oneof" json:"Denom2,omitempty"`
-	Offset  *int32           `protobuf:"varint,4,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TradePairFilter) Reset() {
 	*x = TradePairFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_grpc_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_grpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TradePairFilter) String() string {
@@ -244,7 +254,7 @@ func (*TradePairFilter) ProtoMessage() {}
 
 func (x *TradePairFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_grpc_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -289,7 +299,7 @@ func (x *TradePairFilter) GetOffset() int32 {
 
 var File_domain_trade_trade_grpc_proto protoreflect.FileDescriptor
 
-var file_domain_trade_trade_grpc_proto_rawDesc = []byte{
+var file_domain_trade_trade_grpc_proto_rawDesc = string([]byte{
 	0x0a, 0x1d, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74,
 	0x72, 0x61, 0x64, 0x65, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x05, 0x74, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
@@ -310,7 +320,7 @@ var file_domain_trade_trade_grpc_proto_rawDesc = []byte{
 	0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x54,
 	0x58, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x58, 0x49, 0x44, 0x12,
 	0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9e, 0x04, 0x0a, 0x06,
+	0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xeb, 0x04, 0x0a, 0x06,
 	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
 	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
 	0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77,
@@ -338,67 +348,72 @@ var file_domain_trade_trade_grpc_proto_rawDesc = []byte{
 	0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x04, 0x53, 0x69, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
 	0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x48, 0x09, 0x52, 0x04, 0x53, 0x69, 0x64,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x54, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x54, 0x58,
-	0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x53, 0x69, 0x64, 0x65, 0x22, 0xd2, 0x01, 0x0a,
-	0x0f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a,
-	0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x48, 0x01, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32,
-	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x32, 0xc1, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x09, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2e, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0c,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
-	0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x28,
-	0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x1a, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49,
-	0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x3b, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x19, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x0b, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x54, 0x6f, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x44, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x54, 0x58, 0x49, 0x44, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x53, 0x69, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x29, 0x0a, 0x06, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x48, 0x01, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x02, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x32, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x32, 0xc1,
+	0x02, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x09, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x49,
+	0x44, 0x1a, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x0c, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x28, 0x0a, 0x06, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x1a, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x1a, 0x11,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x73, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x3b, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
 
 var (
 	file_domain_trade_trade_grpc_proto_rawDescOnce sync.Once
-	file_domain_trade_trade_grpc_proto_rawDescData = file_domain_trade_trade_grpc_proto_rawDesc
+	file_domain_trade_trade_grpc_proto_rawDescData []byte
 )
 
 func file_domain_trade_trade_grpc_proto_rawDescGZIP() []byte {
 	file_domain_trade_trade_grpc_proto_rawDescOnce.Do(func() {
-		file_domain_trade_trade_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_domain_trade_trade_grpc_proto_rawDescData)
+		file_domain_trade_trade_grpc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_domain_trade_trade_grpc_proto_rawDesc), len(file_domain_trade_trade_grpc_proto_rawDesc)))
 	})
 	return file_domain_trade_trade_grpc_proto_rawDescData
 }
 
 var file_domain_trade_trade_grpc_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
-var file_domain_trade_trade_grpc_proto_goTypes = []interface{}{
+var file_domain_trade_trade_grpc_proto_goTypes = []any{
 	(*ID)(nil),                    // 0: trade.ID
 	(*Filter)(nil),                // 1: trade.Filter
 	(*TradePairFilter)(nil),       // 2: trade.TradePairFilter
@@ -448,51 +463,13 @@ func file_domain_trade_trade_grpc_proto_init() {
 		return
 	}
 	file_domain_trade_trade_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_domain_trade_trade_grpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_domain_trade_trade_grpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_domain_trade_trade_grpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradePairFilter); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_domain_trade_trade_grpc_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_domain_trade_trade_grpc_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_domain_trade_trade_grpc_proto_msgTypes[1].OneofWrappers = []any{}
+	file_domain_trade_trade_grpc_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_domain_trade_trade_grpc_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_trade_trade_grpc_proto_rawDesc), len(file_domain_trade_trade_grpc_proto_rawDesc)),
 			NumEnums:      0,
 			NumMessages:   3,
 			NumExtensions: 0,
@@ -503,7 +480,6 @@ func file_domain_trade_trade_grpc_proto_init() {
 		MessageInfos:      file_domain_trade_trade_grpc_proto_msgTypes,
 	}.Build()
 	File_domain_trade_trade_grpc_proto = out.File
-	file_domain_trade_trade_grpc_proto_rawDesc = nil
 	file_domain_trade_trade_grpc_proto_goTypes = nil
 	file_domain_trade_trade_grpc_proto_depIdxs = nil
 }
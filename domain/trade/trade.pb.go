@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.28.0
-// 	protoc        v3.20.0
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
 // source: domain/trade/trade.proto
 
 package trade
@@ -16,6 +16,7 @@ import (
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -27,17 +28,14 @@ const (
 
 // Key in store is TXID-Sequence-Metadata.Network
 type Trade struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Account  string           `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
-	OrderID  string           `protobuf:"bytes,2,opt,name=OrderID,proto3" json:"OrderID,omitempty"`    // User assigned order reference
-	Sequence int64            `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // The sequence number of the order, assigned by the DEX (guaranteed unique value for the order)
-	Amount   *decimal.Decimal `protobuf:"bytes,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
-	Price    float64          `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
-	Denom1   *denom.Denom     `protobuf:"bytes,6,opt,name=Denom1,proto3" json:"Denom1,omitempty"`
-	Denom2   *denom.Denom     `protobuf:"bytes,7,opt,name=Denom2,proto3" json:"Denom2,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Account  string                 `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	OrderID  string                 `protobuf:"bytes,2,opt,name=OrderID,proto3" json:"OrderID,omitempty"`    // User assigned order reference
+	Sequence int64                  `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // The sequence number of the order, assigned by the DEX (guaranteed unique value for the order)
+	Amount   *decimal.Decimal       `protobuf:"bytes,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Price    float64                `protobuf:"fixed64,5,opt,name=Price,proto3" json:"Price,omitempty"`
+	Denom1   *denom.Denom           `protobuf:"bytes,6,opt,name=Denom1,proto3" json:"Denom1,omitempty"`
+	Denom2   *denom.Denom           `protobuf:"bytes,7,opt,name=Denom2,proto3" json:"Denom2,omitempty"`
 	// The buy/sell (e.g. did the user place a buy or sell order)
 	Side      order_properties.Side  `protobuf:"varint,8,opt,name=Side,proto3,enum=orderproperties.Side" json:"Side,omitempty"`
 	BlockTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=BlockTime,proto3" json:"BlockTime,omitempty"` // The time the trade was executed in UTC
@@ -52,16 +50,16 @@ type Trade struct {
 	// Trades get stored in alphabetical order of the denom pair.
 	// Data is "uninverted" on retrieval and
 	// this flag only indicates that the denoms as seen in the record are not in the original order
-	Inverted bool `protobuf:"varint,50,opt,name=Inverted,proto3" json:"Inverted,omitempty"`
+	Inverted      bool `protobuf:"varint,50,opt,name=Inverted,proto3" json:"Inverted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Trade) Reset() {
 	*x = Trade{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Trade) String() string {
@@ -72,7 +70,7 @@ func (*Trade) ProtoMessage() {}
 
 func (x *Trade) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -200,20 +198,18 @@ func (x *Trade) GetInverted() bool {
 }
 
 type Trades struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trades        []*Trade               `protobuf:"bytes,1,rep,name=Trades,proto3" json:"Trades,omitempty"`
+	NextCursor    *string                `protobuf:"bytes,2,opt,name=NextCursor,proto3,oneof" json:"NextCursor,omitempty"` // Opaque cursor to retrieve the next page (not set if there are no more results)
 	unknownFields protoimpl.UnknownFields
-
-	Trades []*Trade `protobuf:"bytes,1,rep,name=Trades,proto3" json:"Trades,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Trades) Reset() {
 	*x = Trades{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *Trades) String() string {
@@ -224,7 +220,7 @@ func (*Trades) ProtoMessage() {}
 
 func (x *Trades) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -246,25 +242,29 @@ func (x *Trades) GetTrades() []*Trade {
 	return nil
 }
 
+func (x *Trades) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 type TradePair struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Denom1        *denom.Denom           `protobuf:"bytes,1,opt,name=Denom1,proto3" json:"Denom1,omitempty"`
+	Denom2        *denom.Denom           `protobuf:"bytes,2,opt,name=Denom2,proto3" json:"Denom2,omitempty"`
+	MetaData      *metadata.MetaData     `protobuf:"bytes,3,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
+	PriceTick     *decimal.Decimal       `protobuf:"bytes,4,opt,name=PriceTick,proto3,oneof" json:"PriceTick,omitempty"`
+	QuantityStep  *int64                 `protobuf:"varint,5,opt,name=QuantityStep,proto3,oneof" json:"QuantityStep,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	Denom1       *denom.Denom       `protobuf:"bytes,1,opt,name=Denom1,proto3" json:"Denom1,omitempty"`
-	Denom2       *denom.Denom       `protobuf:"bytes,2,opt,name=Denom2,proto3" json:"Denom2,omitempty"`
-	MetaData     *metadata.MetaData `protobuf:"bytes,3,opt,name=MetaData,proto3" json:"MetaData,omitempty"`
-	PriceTick    *decimal.Decimal   `protobuf:"bytes,4,opt,name=PriceTick,proto3,oneof" json:"PriceTick,omitempty"`
-	QuantityStep *int64             `protobuf:"varint,5,opt,name=QuantityStep,proto3,oneof" json:"QuantityStep,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TradePair) Reset() {
 	*x = TradePair{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TradePair) String() string {
@@ -275,7 +275,7 @@ func (*TradePair) ProtoMessage() {}
 
 func (x *TradePair) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -326,21 +326,18 @@ func (x *TradePair) GetQuantityStep() int64 {
 }
 
 type TradePairs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradePairs    []*TradePair           `protobuf:"bytes,1,rep,name=TradePairs,proto3" json:"TradePairs,omitempty"`
+	Offset        *int32                 `protobuf:"varint,2,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
-
-	TradePairs []*TradePair `protobuf:"bytes,1,rep,name=TradePairs,proto3" json:"TradePairs,omitempty"`
-	Offset     *int32       `protobuf:"varint,2,opt,name=Offset,proto3,oneof" json:"Offset,omitempty"`
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TradePairs) Reset() {
 	*x = TradePairs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_domain_trade_trade_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+	mi := &file_domain_trade_trade_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *TradePairs) String() string {
@@ -351,7 +348,7 @@ func (*TradePairs) ProtoMessage() {}
 
 func (x *TradePairs) ProtoReflect() protoreflect.Message {
 	mi := &file_domain_trade_trade_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
+	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
@@ -382,7 +379,7 @@ func (x *TradePairs) GetOffset() int32 {
 
 var File_domain_trade_trade_proto protoreflect.FileDescriptor
 
-var file_domain_trade_trade_proto_rawDesc = []byte{
+var file_domain_trade_trade_proto_rawDesc = string([]byte{
 	0x0a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74,
 	0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x72, 0x61, 0x64,
 	0x65, 0x1a, 0x18, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2f,
@@ -430,54 +427,57 @@ var file_domain_trade_trade_proto_rawDesc = []byte{
 	0x44, 0x18, 0x28, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x03, 0x55, 0x53, 0x44, 0x88, 0x01,
 	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x32, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x54, 0x58, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x55, 0x53, 0x44, 0x22, 0x2e,
+	0x05, 0x5f, 0x54, 0x58, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x55, 0x53, 0x44, 0x22, 0x62,
 	0x0a, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x64,
 	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x84,
-	0x02, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x24, 0x0a, 0x06,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x31, 0x12, 0x24, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x12, 0x2e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x64, 0x65,
-	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52,
-	0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
-	0x0c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x65, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x54, 0x69, 0x63, 0x6b, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x53, 0x74, 0x65, 0x70, 0x22, 0x66, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61,
-	0x69, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88,
-	0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x3c, 0x5a,
-	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x72, 0x65,
-	0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x72,
-	0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x3b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x84, 0x02, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x24, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x06,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x31, 0x12, 0x24, 0x0a, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x06, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x32, 0x12, 0x2e, 0x0a, 0x08,
+	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x09,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
+	0x6c, 0x48, 0x00, 0x52, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x65,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x65, 0x70, 0x22, 0x66, 0x0a, 0x0a, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0a, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x3b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
 
 var (
 	file_domain_trade_trade_proto_rawDescOnce sync.Once
-	file_domain_trade_trade_proto_rawDescData = file_domain_trade_trade_proto_rawDesc
+	file_domain_trade_trade_proto_rawDescData []byte
 )
 
 func file_domain_trade_trade_proto_rawDescGZIP() []byte {
 	file_domain_trade_trade_proto_rawDescOnce.Do(func() {
-		file_domain_trade_trade_proto_rawDescData = protoimpl.X.CompressGZIP(file_domain_trade_trade_proto_rawDescData)
+		file_domain_trade_trade_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_domain_trade_trade_proto_rawDesc), len(file_domain_trade_trade_proto_rawDesc)))
 	})
 	return file_domain_trade_trade_proto_rawDescData
 }
 
 var file_domain_trade_trade_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_domain_trade_trade_proto_goTypes = []interface{}{
+var file_domain_trade_trade_proto_goTypes = []any{
 	(*Trade)(nil),                 // 0: trade.Trade
 	(*Trades)(nil),                // 1: trade.Trades
 	(*TradePair)(nil),             // 2: trade.TradePair
@@ -513,64 +513,15 @@ func file_domain_trade_trade_proto_init() {
 	if File_domain_trade_trade_proto != nil {
 		return
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_domain_trade_trade_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trade); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_domain_trade_trade_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Trades); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_domain_trade_trade_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradePair); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_domain_trade_trade_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradePairs); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	file_domain_trade_trade_proto_msgTypes[0].OneofWrappers = []interface{}{}
-	file_domain_trade_trade_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_domain_trade_trade_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_domain_trade_trade_proto_msgTypes[0].OneofWrappers = []any{}
+	file_domain_trade_trade_proto_msgTypes[1].OneofWrappers = []any{}
+	file_domain_trade_trade_proto_msgTypes[2].OneofWrappers = []any{}
+	file_domain_trade_trade_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_domain_trade_trade_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_trade_trade_proto_rawDesc), len(file_domain_trade_trade_proto_rawDesc)),
 			NumEnums:      0,
 			NumMessages:   4,
 			NumExtensions: 0,
@@ -581,7 +532,6 @@ func file_domain_trade_trade_proto_init() {
 		MessageInfos:      file_domain_trade_trade_proto_msgTypes,
 	}.Build()
 	File_domain_trade_trade_proto = out.File
-	file_domain_trade_trade_proto_rawDesc = nil
 	file_domain_trade_trade_proto_goTypes = nil
 	file_domain_trade_trade_proto_depIdxs = nil
 }
@@ -56,6 +56,8 @@ export interface Trade {
 
 export interface Trades {
   Trades: Trade[];
+  /** Opaque cursor to retrieve the next page (not set if there are no more results) */
+  NextCursor?: string | undefined;
 }
 
 export interface TradePair {
@@ -381,7 +383,7 @@ export const Trade = {
 };
 
 function createBaseTrades(): Trades {
-  return { Trades: [] };
+  return { Trades: [], NextCursor: undefined };
 }
 
 export const Trades = {
@@ -389,6 +391,9 @@ export const Trades = {
     for (const v of message.Trades) {
       Trade.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    if (message.NextCursor !== undefined) {
+      writer.uint32(18).string(message.NextCursor);
+    }
     return writer;
   },
 
@@ -406,6 +411,13 @@ export const Trades = {
 
           message.Trades.push(Trade.decode(reader, reader.uint32()));
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.NextCursor = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -416,7 +428,10 @@ export const Trades = {
   },
 
   fromJSON(object: any): Trades {
-    return { Trades: globalThis.Array.isArray(object?.Trades) ? object.Trades.map((e: any) => Trade.fromJSON(e)) : [] };
+    return {
+      Trades: globalThis.Array.isArray(object?.Trades) ? object.Trades.map((e: any) => Trade.fromJSON(e)) : [],
+      NextCursor: isSet(object.NextCursor) ? globalThis.String(object.NextCursor) : undefined,
+    };
   },
 
   toJSON(message: Trades): unknown {
@@ -424,6 +439,9 @@ export const Trades = {
     if (message.Trades?.length) {
       obj.Trades = message.Trades.map((e) => Trade.toJSON(e));
     }
+    if (message.NextCursor !== undefined) {
+      obj.NextCursor = message.NextCursor;
+    }
     return obj;
   },
 
@@ -433,6 +451,7 @@ export const Trades = {
   fromPartial<I extends Exact<DeepPartial<Trades>, I>>(object: I): Trades {
     const message = createBaseTrades();
     message.Trades = object.Trades?.map((e) => Trade.fromPartial(e)) || [];
+    message.NextCursor = object.NextCursor ?? undefined;
     return message;
   },
 };
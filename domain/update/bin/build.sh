@@ -8,12 +8,17 @@ cd $rd
 protoc \
   --proto_path=. "domain/update/update.proto" \
   "--go_out=." --go_opt=paths=source_relative
+
+# The typed payloads of the binary websocket messages (Go only, other clients generate from the proto)
+protoc \
+  --proto_path=. "domain/update/payload.proto" \
+  "--go_out=." --go_opt=paths=source_relative
   
-# protoc \
-#   --proto_path=. "domain/update/update-grpc.proto" \
-#   "--go_out=." --go_opt=paths=source_relative \
-#   --go-grpc_opt=require_unimplemented_servers=false \
-#   "--go-grpc_out=." --go-grpc_opt=paths=source_relative
+protoc \
+  --proto_path=. "domain/update/update-grpc.proto" \
+  "--go_out=." --go_opt=paths=source_relative \
+  --go-grpc_opt=require_unimplemented_servers=false \
+  "--go-grpc_out=." --go-grpc_opt=paths=source_relative
 
 cp domain/update/package.json .
 cp domain/update/tsconfig.json .
@@ -22,6 +22,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UpdateType int32
+
+const (
+	UpdateType_SNAPSHOT UpdateType = 0 // The complete content of the channel
+	UpdateType_DELTA    UpdateType = 1 // The changes since the previous message (see the README for the content per method)
+)
+
+// Enum value maps for UpdateType.
+var (
+	UpdateType_name = map[int32]string{
+		0: "SNAPSHOT",
+		1: "DELTA",
+	}
+	UpdateType_value = map[string]int32{
+		"SNAPSHOT": 0,
+		"DELTA":    1,
+	}
+)
+
+func (x UpdateType) Enum() *UpdateType {
+	p := new(UpdateType)
+	*p = x
+	return p
+}
+
+func (x UpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_domain_update_update_proto_enumTypes[0].Descriptor()
+}
+
+func (UpdateType) Type() protoreflect.EnumType {
+	return &file_domain_update_update_proto_enumTypes[0]
+}
+
+func (x UpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateType.Descriptor instead.
+func (UpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_domain_update_update_proto_rawDescGZIP(), []int{0}
+}
+
 type Action int32
 
 const (
@@ -29,6 +75,8 @@ const (
 	Action_UNSUBSCRIBE Action = 1
 	Action_CLOSE       Action = 2
 	Action_RESPONSE    Action = 3
+	Action_ACK         Action = 4 // The subscribe or unsubscribe of the Subscription succeeded
+	Action_ERROR       Action = 5 // The request failed, see Error
 )
 
 // Enum value maps for Action.
@@ -38,12 +86,16 @@ var (
 		1: "UNSUBSCRIBE",
 		2: "CLOSE",
 		3: "RESPONSE",
+		4: "ACK",
+		5: "ERROR",
 	}
 	Action_value = map[string]int32{
 		"SUBSCRIBE":   0,
 		"UNSUBSCRIBE": 1,
 		"CLOSE":       2,
 		"RESPONSE":    3,
+		"ACK":         4,
+		"ERROR":       5,
 	}
 )
 
@@ -58,11 +110,11 @@ func (x Action) String() string {
 }
 
 func (Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_domain_update_update_proto_enumTypes[0].Descriptor()
+	return file_domain_update_update_proto_enumTypes[1].Descriptor()
 }
 
 func (Action) Type() protoreflect.EnumType {
-	return &file_domain_update_update_proto_enumTypes[0]
+	return &file_domain_update_update_proto_enumTypes[1]
 }
 
 func (x Action) Number() protoreflect.EnumNumber {
@@ -71,35 +123,43 @@ func (x Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Action.Descriptor instead.
 func (Action) EnumDescriptor() ([]byte, []int) {
-	return file_domain_update_update_proto_rawDescGZIP(), []int{0}
+	return file_domain_update_update_proto_rawDescGZIP(), []int{1}
 }
 
 type Method int32
 
 const (
 	Method_METHOD_DO_NOT_USE                Method = 0
-	Method_TRADES_FOR_SYMBOL                Method = 1 // ID: {denom1}_{denom2}
-	Method_TRADES_FOR_ACCOUNT               Method = 2 // ID: {account}
-	Method_TRADES_FOR_ACCOUNT_AND_SYMBOL    Method = 3 // ID: {account}_{denom1}_{denom2}
-	Method_OHLC                             Method = 4 // ID: {denom1}_{denom2}_{interval}
-	Method_TICKER                           Method = 5 // ID: {denom1}_{denom2}
-	Method_ORDERBOOK                        Method = 6 // ID: {denom1}_{denom2}
-	Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT Method = 7 // ID: {account}_{denom1}_{denom2}
-	Method_WALLET                           Method = 8 // ID: {account}
+	Method_TRADES_FOR_SYMBOL                Method = 1  // ID: {denom1}_{denom2}
+	Method_TRADES_FOR_ACCOUNT               Method = 2  // ID: {account}
+	Method_TRADES_FOR_ACCOUNT_AND_SYMBOL    Method = 3  // ID: {account}_{denom1}_{denom2}
+	Method_OHLC                             Method = 4  // ID: {denom1}_{denom2}_{interval}
+	Method_TICKER                           Method = 5  // ID: {denom1}_{denom2}
+	Method_ORDERBOOK                        Method = 6  // ID: {denom1}_{denom2}
+	Method_ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT Method = 7  // ID: {account}_{denom1}_{denom2}
+	Method_WALLET                           Method = 8  // ID: {account}
+	Method_TX_STATUS                        Method = 9  // ID: {txhash}
+	Method_ORDERBOOKS_FOR_ACCOUNT           Method = 10 // ID: {account}
+	Method_DEPTH                            Method = 11 // ID: {denom1}_{denom2}_{grouping}
+	Method_ORDERS_FOR_ACCOUNT               Method = 12 // ID: {account} or {account}_{denom1}_{denom2}
 )
 
 // Enum value maps for Method.
 var (
 	Method_name = map[int32]string{
-		0: "METHOD_DO_NOT_USE",
-		1: "TRADES_FOR_SYMBOL",
-		2: "TRADES_FOR_ACCOUNT",
-		3: "TRADES_FOR_ACCOUNT_AND_SYMBOL",
-		4: "OHLC",
-		5: "TICKER",
-		6: "ORDERBOOK",
-		7: "ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT",
-		8: "WALLET",
+		0:  "METHOD_DO_NOT_USE",
+		1:  "TRADES_FOR_SYMBOL",
+		2:  "TRADES_FOR_ACCOUNT",
+		3:  "TRADES_FOR_ACCOUNT_AND_SYMBOL",
+		4:  "OHLC",
+		5:  "TICKER",
+		6:  "ORDERBOOK",
+		7:  "ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT",
+		8:  "WALLET",
+		9:  "TX_STATUS",
+		10: "ORDERBOOKS_FOR_ACCOUNT",
+		11: "DEPTH",
+		12: "ORDERS_FOR_ACCOUNT",
 	}
 	Method_value = map[string]int32{
 		"METHOD_DO_NOT_USE":                0,
@@ -111,6 +171,10 @@ var (
 		"ORDERBOOK":                        6,
 		"ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT": 7,
 		"WALLET":                           8,
+		"TX_STATUS":                        9,
+		"ORDERBOOKS_FOR_ACCOUNT":           10,
+		"DEPTH":                            11,
+		"ORDERS_FOR_ACCOUNT":               12,
 	}
 )
 
@@ -125,11 +189,11 @@ func (x Method) String() string {
 }
 
 func (Method) Descriptor() protoreflect.EnumDescriptor {
-	return file_domain_update_update_proto_enumTypes[1].Descriptor()
+	return file_domain_update_update_proto_enumTypes[2].Descriptor()
 }
 
 func (Method) Type() protoreflect.EnumType {
-	return &file_domain_update_update_proto_enumTypes[1]
+	return &file_domain_update_update_proto_enumTypes[2]
 }
 
 func (x Method) Number() protoreflect.EnumNumber {
@@ -138,13 +202,15 @@ func (x Method) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Method.Descriptor instead.
 func (Method) EnumDescriptor() ([]byte, []int) {
-	return file_domain_update_update_proto_rawDescGZIP(), []int{1}
+	return file_domain_update_update_proto_rawDescGZIP(), []int{2}
 }
 
 type Subscribe struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Action        Action                 `protobuf:"varint,1,opt,name=Action,proto3,enum=update.Action" json:"Action,omitempty"`
-	Subscription  *Subscription          `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Action       Action                 `protobuf:"varint,1,opt,name=Action,proto3,enum=update.Action" json:"Action,omitempty"`
+	Subscription *Subscription          `protobuf:"bytes,2,opt,name=Subscription,proto3" json:"Subscription,omitempty"`
+	// Reason of an ERROR
+	Error         string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -193,12 +259,23 @@ func (x *Subscribe) GetSubscription() *Subscription {
 	return nil
 }
 
+func (x *Subscribe) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type Subscription struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        Method                 `protobuf:"varint,1,opt,name=Method,proto3,enum=update.Method" json:"Method,omitempty"`
-	ID            string                 `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
-	Network       metadata.Network       `protobuf:"varint,3,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Method  Method                 `protobuf:"varint,1,opt,name=Method,proto3,enum=update.Method" json:"Method,omitempty"`
+	ID      string                 `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Network metadata.Network       `protobuf:"varint,3,opt,name=Network,proto3,enum=metadata.Network" json:"Network,omitempty"`
+	Content string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	// Sequence of the message in the channel (Network, Method and ID), increases by 1 with every message.
+	// A gap means a message was missed: Resubscribe to receive a new snapshot.
+	Sequence      int64      `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Type          UpdateType `protobuf:"varint,6,opt,name=Type,proto3,enum=update.UpdateType" json:"Type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -261,6 +338,20 @@ func (x *Subscription) GetContent() string {
 	return ""
 }
 
+func (x *Subscription) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Subscription) GetType() UpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return UpdateType_SNAPSHOT
+}
+
 var File_domain_update_update_proto protoreflect.FileDescriptor
 
 var file_domain_update_update_proto_rawDesc = string([]byte{
@@ -268,44 +359,58 @@ var file_domain_update_update_proto_rawDesc = string([]byte{
 	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x75, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x1a, 0x1e, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74,
 	0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x12, 0x26, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0e, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x2a, 0x41, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0d, 0x0a,
-	0x09, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
-	0x55, 0x4e, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x50,
-	0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03, 0x2a, 0xc8, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x4f, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x44,
-	0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x01, 0x12,
-	0x16, 0x0a, 0x12, 0x54, 0x52, 0x41, 0x44, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x44, 0x45,
-	0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x4e,
-	0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x48,
-	0x4c, 0x43, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x05,
-	0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x4f, 0x4b, 0x10, 0x06, 0x12,
-	0x24, 0x0a, 0x20, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x46, 0x4f, 0x52,
-	0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f,
-	0x55, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x10,
-	0x08, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49, 0x2f, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0c, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x0c, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x25,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45,
+	0x4c, 0x54, 0x41, 0x10, 0x01, 0x2a, 0x55, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x43, 0x4b, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x2a, 0x96, 0x02, 0x0a,
+	0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x44, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x54, 0x52, 0x41, 0x44, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x59, 0x4d,
+	0x42, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x52, 0x41, 0x44, 0x45, 0x53, 0x5f,
+	0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x52, 0x41, 0x44, 0x45, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x4f, 0x48, 0x4c, 0x43, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x49,
+	0x43, 0x4b, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42,
+	0x4f, 0x4f, 0x4b, 0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x41, 0x4e,
+	0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x57,
+	0x41, 0x4c, 0x4c, 0x45, 0x54, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x58, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x42,
+	0x4f, 0x4f, 0x4b, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x0a, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x50, 0x54, 0x48, 0x10, 0x0b, 0x12, 0x16, 0x0a,
+	0x12, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x0c, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x75, 0x6d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x72, 0x65, 0x44, 0x45, 0x58, 0x2d, 0x41, 0x50, 0x49,
+	0x2f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -320,25 +425,27 @@ func file_domain_update_update_proto_rawDescGZIP() []byte {
 	return file_domain_update_update_proto_rawDescData
 }
 
-var file_domain_update_update_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_domain_update_update_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_domain_update_update_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_domain_update_update_proto_goTypes = []any{
-	(Action)(0),           // 0: update.Action
-	(Method)(0),           // 1: update.Method
-	(*Subscribe)(nil),     // 2: update.Subscribe
-	(*Subscription)(nil),  // 3: update.Subscription
-	(metadata.Network)(0), // 4: metadata.Network
+	(UpdateType)(0),       // 0: update.UpdateType
+	(Action)(0),           // 1: update.Action
+	(Method)(0),           // 2: update.Method
+	(*Subscribe)(nil),     // 3: update.Subscribe
+	(*Subscription)(nil),  // 4: update.Subscription
+	(metadata.Network)(0), // 5: metadata.Network
 }
 var file_domain_update_update_proto_depIdxs = []int32{
-	0, // 0: update.Subscribe.Action:type_name -> update.Action
-	3, // 1: update.Subscribe.Subscription:type_name -> update.Subscription
-	1, // 2: update.Subscription.Method:type_name -> update.Method
-	4, // 3: update.Subscription.Network:type_name -> metadata.Network
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1, // 0: update.Subscribe.Action:type_name -> update.Action
+	4, // 1: update.Subscribe.Subscription:type_name -> update.Subscription
+	2, // 2: update.Subscription.Method:type_name -> update.Method
+	5, // 3: update.Subscription.Network:type_name -> metadata.Network
+	0, // 4: update.Subscription.Type:type_name -> update.UpdateType
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_domain_update_update_proto_init() }
@@ -351,7 +458,7 @@ func file_domain_update_update_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_domain_update_update_proto_rawDesc), len(file_domain_update_update_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
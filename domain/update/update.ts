@@ -5,16 +5,56 @@
 // source: domain/update/update.proto
 
 /* eslint-disable */
+import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Network, networkFromJSON, networkToJSON } from "../metadata/metadata";
 
 export const protobufPackage = "update";
 
+export enum UpdateType {
+  /** SNAPSHOT - The complete content of the channel */
+  SNAPSHOT = 0,
+  /** DELTA - The changes since the previous message (see the README for the content per method) */
+  DELTA = 1,
+  UNRECOGNIZED = -1,
+}
+
+export function updateTypeFromJSON(object: any): UpdateType {
+  switch (object) {
+    case 0:
+    case "SNAPSHOT":
+      return UpdateType.SNAPSHOT;
+    case 1:
+    case "DELTA":
+      return UpdateType.DELTA;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return UpdateType.UNRECOGNIZED;
+  }
+}
+
+export function updateTypeToJSON(object: UpdateType): string {
+  switch (object) {
+    case UpdateType.SNAPSHOT:
+      return "SNAPSHOT";
+    case UpdateType.DELTA:
+      return "DELTA";
+    case UpdateType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export enum Action {
   SUBSCRIBE = 0,
   UNSUBSCRIBE = 1,
   CLOSE = 2,
   RESPONSE = 3,
+  /** ACK - The subscribe or unsubscribe of the Subscription succeeded */
+  ACK = 4,
+  /** ERROR - The request failed, see Error */
+  ERROR = 5,
   UNRECOGNIZED = -1,
 }
 
@@ -32,6 +72,12 @@ export function actionFromJSON(object: any): Action {
     case 3:
     case "RESPONSE":
       return Action.RESPONSE;
+    case 4:
+    case "ACK":
+      return Action.ACK;
+    case 5:
+    case "ERROR":
+      return Action.ERROR;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -49,6 +95,10 @@ export function actionToJSON(object: Action): string {
       return "CLOSE";
     case Action.RESPONSE:
       return "RESPONSE";
+    case Action.ACK:
+      return "ACK";
+    case Action.ERROR:
+      return "ERROR";
     case Action.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -73,6 +123,14 @@ export enum Method {
   ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT = 7,
   /** WALLET - ID: {account} */
   WALLET = 8,
+  /** TX_STATUS - ID: {txhash} */
+  TX_STATUS = 9,
+  /** ORDERBOOKS_FOR_ACCOUNT - ID: {account} */
+  ORDERBOOKS_FOR_ACCOUNT = 10,
+  /** DEPTH - ID: {denom1}_{denom2}_{grouping} */
+  DEPTH = 11,
+  /** ORDERS_FOR_ACCOUNT - ID: {account} or {account}_{denom1}_{denom2} */
+  ORDERS_FOR_ACCOUNT = 12,
   UNRECOGNIZED = -1,
 }
 
@@ -105,6 +163,18 @@ export function methodFromJSON(object: any): Method {
     case 8:
     case "WALLET":
       return Method.WALLET;
+    case 9:
+    case "TX_STATUS":
+      return Method.TX_STATUS;
+    case 10:
+    case "ORDERBOOKS_FOR_ACCOUNT":
+      return Method.ORDERBOOKS_FOR_ACCOUNT;
+    case 11:
+    case "DEPTH":
+      return Method.DEPTH;
+    case 12:
+    case "ORDERS_FOR_ACCOUNT":
+      return Method.ORDERS_FOR_ACCOUNT;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -132,6 +202,14 @@ export function methodToJSON(object: Method): string {
       return "ORDERBOOK_FOR_SYMBOL_AND_ACCOUNT";
     case Method.WALLET:
       return "WALLET";
+    case Method.TX_STATUS:
+      return "TX_STATUS";
+    case Method.ORDERBOOKS_FOR_ACCOUNT:
+      return "ORDERBOOKS_FOR_ACCOUNT";
+    case Method.DEPTH:
+      return "DEPTH";
+    case Method.ORDERS_FOR_ACCOUNT:
+      return "ORDERS_FOR_ACCOUNT";
     case Method.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -140,7 +218,11 @@ export function methodToJSON(object: Method): string {
 
 export interface Subscribe {
   Action: Action;
-  Subscription: Subscription | undefined;
+  Subscription:
+    | Subscription
+    | undefined;
+  /** Reason of an ERROR */
+  Error: string;
 }
 
 export interface Subscription {
@@ -148,10 +230,16 @@ export interface Subscription {
   ID: string;
   Network: Network;
   Content: string;
+  /**
+   * Sequence of the message in the channel (Network, Method and ID), increases by 1 with every message.
+   * A gap means a message was missed: Resubscribe to receive a new snapshot.
+   */
+  Sequence: number;
+  Type: UpdateType;
 }
 
 function createBaseSubscribe(): Subscribe {
-  return { Action: 0, Subscription: undefined };
+  return { Action: 0, Subscription: undefined, Error: "" };
 }
 
 export const Subscribe = {
@@ -162,6 +250,9 @@ export const Subscribe = {
     if (message.Subscription !== undefined) {
       Subscription.encode(message.Subscription, writer.uint32(18).fork()).ldelim();
     }
+    if (message.Error !== "") {
+      writer.uint32(26).string(message.Error);
+    }
     return writer;
   },
 
@@ -186,6 +277,13 @@ export const Subscribe = {
 
           message.Subscription = Subscription.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.Error = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -199,6 +297,7 @@ export const Subscribe = {
     return {
       Action: isSet(object.Action) ? actionFromJSON(object.Action) : 0,
       Subscription: isSet(object.Subscription) ? Subscription.fromJSON(object.Subscription) : undefined,
+      Error: isSet(object.Error) ? globalThis.String(object.Error) : "",
     };
   },
 
@@ -210,6 +309,9 @@ export const Subscribe = {
     if (message.Subscription !== undefined) {
       obj.Subscription = Subscription.toJSON(message.Subscription);
     }
+    if (message.Error !== "") {
+      obj.Error = message.Error;
+    }
     return obj;
   },
 
@@ -222,12 +324,13 @@ export const Subscribe = {
     message.Subscription = (object.Subscription !== undefined && object.Subscription !== null)
       ? Subscription.fromPartial(object.Subscription)
       : undefined;
+    message.Error = object.Error ?? "";
     return message;
   },
 };
 
 function createBaseSubscription(): Subscription {
-  return { Method: 0, ID: "", Network: 0, Content: "" };
+  return { Method: 0, ID: "", Network: 0, Content: "", Sequence: 0, Type: 0 };
 }
 
 export const Subscription = {
@@ -244,6 +347,12 @@ export const Subscription = {
     if (message.Content !== "") {
       writer.uint32(34).string(message.Content);
     }
+    if (message.Sequence !== 0) {
+      writer.uint32(40).int64(message.Sequence);
+    }
+    if (message.Type !== 0) {
+      writer.uint32(48).int32(message.Type);
+    }
     return writer;
   },
 
@@ -282,6 +391,20 @@ export const Subscription = {
 
           message.Content = reader.string();
           continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.Sequence = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.Type = reader.int32() as any;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -297,6 +420,8 @@ export const Subscription = {
       ID: isSet(object.ID) ? globalThis.String(object.ID) : "",
       Network: isSet(object.Network) ? networkFromJSON(object.Network) : 0,
       Content: isSet(object.Content) ? globalThis.String(object.Content) : "",
+      Sequence: isSet(object.Sequence) ? globalThis.Number(object.Sequence) : 0,
+      Type: isSet(object.Type) ? updateTypeFromJSON(object.Type) : 0,
     };
   },
 
@@ -314,6 +439,12 @@ export const Subscription = {
     if (message.Content !== "") {
       obj.Content = message.Content;
     }
+    if (message.Sequence !== 0) {
+      obj.Sequence = Math.round(message.Sequence);
+    }
+    if (message.Type !== 0) {
+      obj.Type = updateTypeToJSON(message.Type);
+    }
     return obj;
   },
 
@@ -326,6 +457,8 @@ export const Subscription = {
     message.ID = object.ID ?? "";
     message.Network = object.Network ?? 0;
     message.Content = object.Content ?? "";
+    message.Sequence = object.Sequence ?? 0;
+    message.Type = object.Type ?? 0;
     return message;
   },
 };
@@ -342,6 +475,21 @@ type KeysOfUnion<T> = T extends T ? keyof T : never;
 export type Exact<P, I extends P> = P extends Builtin ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
 
+function longToNumber(long: Long): number {
+  if (long.gt(globalThis.Number.MAX_SAFE_INTEGER)) {
+    throw new globalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  if (long.lt(globalThis.Number.MIN_SAFE_INTEGER)) {
+    throw new globalThis.Error("Value is smaller than Number.MIN_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
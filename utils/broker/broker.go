@@ -0,0 +1,42 @@
+/*
+The broker distributes messages over the replicas of an app:
+- The replicas exchange messages over topics (publish/subscribe)
+- One replica can be elected, e.g. to produce the websocket updates of the api-server which all replicas fan out
+
+Without configuration (the endpoint variable not set) the in-process broker is used, which only works for a single
+replica. With the endpoint variable set to a redis URL (redis://{host}:6379/0) the replicas use redis.
+*/
+package broker
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/CoreumFoundation/CoreDEX-API/utils/logger"
+)
+
+type Broker interface {
+	// Publish sends the message to all subscribers of the topic (in all replicas)
+	Publish(ctx context.Context, topic string, message []byte) error
+	// Subscribe returns the messages of the topic in the order they were published, until the context is done.
+	// The channel is closed when the subscription ends.
+	Subscribe(ctx context.Context, topic string) (<-chan []byte, error)
+	// Campaign acquires or extends the leadership of the election for the candidate for the ttl.
+	// Returns true if the candidate is the leader.
+	Campaign(ctx context.Context, election, candidate string, ttl time.Duration) (bool, error)
+}
+
+// New returns the broker as configured by the environment variable endpoint
+func New(endpoint string) Broker {
+	url := os.Getenv(endpoint)
+	if url == "" {
+		logger.Infof("%s is not set, using the in-process broker (single replica)", endpoint)
+		return NewMemory()
+	}
+	b, err := NewRedis(url)
+	if err != nil {
+		logger.Fatalf("%s is invalid: %v", endpoint, err)
+	}
+	return b
+}
@@ -0,0 +1,103 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SUBSCRIBER_BUFFER is the number of messages buffered per subscriber of the in-process broker
+const SUBSCRIBER_BUFFER = 1000
+
+// Memory is the in-process broker: The topics only reach the subscribers in the same process.
+// Used for a single replica and as broker in tests (several replicas sharing the same Memory).
+type Memory struct {
+	mutex       sync.RWMutex
+	subscribers map[string]map[*subscriber]bool
+	leaders     map[string]leadership
+}
+
+type subscriber struct {
+	messages chan []byte
+	done     chan struct{} // Closed when the subscription ended
+}
+
+type leadership struct {
+	candidate string
+	expires   time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		subscribers: make(map[string]map[*subscriber]bool),
+		leaders:     make(map[string]leadership),
+	}
+}
+
+// Publish delivers the message to the subscribers of the topic, blocking while a subscriber is full so that no
+// message is lost (like a broker which buffers for its clients)
+func (m *Memory) Publish(ctx context.Context, topic string, message []byte) error {
+	m.mutex.RLock()
+	subscribers := make([]*subscriber, 0, len(m.subscribers[topic]))
+	for s := range m.subscribers[topic] {
+		subscribers = append(subscribers, s)
+	}
+	m.mutex.RUnlock()
+	for _, s := range subscribers {
+		select {
+		case s.messages <- message:
+		case <-s.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (m *Memory) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	s := &subscriber{
+		messages: make(chan []byte, SUBSCRIBER_BUFFER),
+		done:     make(chan struct{}),
+	}
+	m.mutex.Lock()
+	if m.subscribers[topic] == nil {
+		m.subscribers[topic] = make(map[*subscriber]bool)
+	}
+	m.subscribers[topic][s] = true
+	m.mutex.Unlock()
+	// The messages are forwarded so that the returned channel can be closed without racing the publishers
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer func() {
+			m.mutex.Lock()
+			delete(m.subscribers[topic], s)
+			m.mutex.Unlock()
+			close(s.done)
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message := <-s.messages:
+				select {
+				case out <- message:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (m *Memory) Campaign(_ context.Context, election, candidate string, ttl time.Duration) (bool, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	now := time.Now()
+	if current, ok := m.leaders[election]; ok && current.candidate != candidate && now.Before(current.expires) {
+		return false, nil
+	}
+	m.leaders[election] = leadership{candidate: candidate, expires: now.Add(ttl)}
+	return true, nil
+}
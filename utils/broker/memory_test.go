@@ -0,0 +1,91 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_MemoryPublish(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b := NewMemory()
+	first, err := b.Subscribe(ctx, "topic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b.Subscribe(ctx, "topic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := b.Subscribe(ctx, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []string{"1", "2"} {
+		if err := b.Publish(ctx, "topic", []byte(m)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// All subscribers receive the messages in order
+	for _, messages := range []<-chan []byte{first, second} {
+		for _, expected := range []string{"1", "2"} {
+			select {
+			case m := <-messages:
+				if string(m) != expected {
+					t.Errorf("received %s, expected %s", m, expected)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("message %s not received", expected)
+			}
+		}
+	}
+	select {
+	case m := <-other:
+		t.Errorf("received %s on another topic", m)
+	default:
+	}
+}
+
+func Test_MemorySubscriptionEnds(t *testing.T) {
+	b := NewMemory()
+	ctx, cancel := context.WithCancel(context.Background())
+	messages, err := b.Subscribe(ctx, "topic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Errorf("message received after the end of the subscription")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("channel not closed")
+	}
+	// Publishing does not block on the ended subscription
+	for i := 0; i < SUBSCRIBER_BUFFER+1; i++ {
+		if err := b.Publish(context.Background(), "topic", []byte("m")); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_MemoryCampaign(t *testing.T) {
+	ctx := context.Background()
+	b := NewMemory()
+	if leader, _ := b.Campaign(ctx, "election", "a", 50*time.Millisecond); !leader {
+		t.Errorf("a not elected")
+	}
+	if leader, _ := b.Campaign(ctx, "election", "b", 50*time.Millisecond); leader {
+		t.Errorf("b elected while a leads")
+	}
+	// The leader extends the leadership
+	if leader, _ := b.Campaign(ctx, "election", "a", 50*time.Millisecond); !leader {
+		t.Errorf("a lost the leadership")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if leader, _ := b.Campaign(ctx, "election", "b", 50*time.Millisecond); !leader {
+		t.Errorf("b not elected after the leadership of a expired")
+	}
+}
@@ -0,0 +1,82 @@
+package broker
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// REDIS_CHANNEL_SIZE is the number of messages buffered per subscription before redis messages are dropped
+const REDIS_CHANNEL_SIZE = 1000
+
+// extendLeadership extends the leadership if the candidate holds it, acquires it when nobody holds it
+var extendLeadership = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+if not current then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// Redis is the broker over redis pub/sub, for multiple replicas.
+// The election is a key holding the leader which expires when the leader does not extend it.
+type Redis struct {
+	client *redis.Client
+}
+
+func NewRedis(url string) (*Redis, error) {
+	opt, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &Redis{client: redis.NewClient(opt)}, nil
+}
+
+func (r *Redis) Publish(ctx context.Context, topic string, message []byte) error {
+	return r.client.Publish(ctx, topic, message).Err()
+}
+
+func (r *Redis) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	pubsub := r.client.Subscribe(ctx, topic)
+	// Wait for the confirmation so that no message published after the return is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	messages := pubsub.Channel(redis.WithChannelSize(REDIS_CHANNEL_SIZE))
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				select {
+				case out <- []byte(message.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (r *Redis) Campaign(ctx context.Context, election, candidate string, ttl time.Duration) (bool, error) {
+	res, err := extendLeadership.Run(ctx, r.client, []string{election}, candidate, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}